@@ -18,7 +18,6 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
-	"github.com/evgeniy-krivenko/grpc-notes/pkg/grpcx"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
 )
 
@@ -32,6 +31,10 @@ func run() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
 	defer cancel()
 
+	// AUTH_TOKEN is a JWT with the user id as the subject.
+	md := metadata.New(map[string]string{"authorization": "Bearer " + os.Getenv("AUTH_TOKEN")})
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
 	if err := slogx.InitGlobal(
 		os.Stdout,
 		"info",
@@ -65,7 +68,7 @@ func run() error {
 }
 
 func subscribeToEvents(ctx context.Context, client pb.NoteAPIClient) error {
-	req := pb.SubscribeToEventRequest{UserId: 1}
+	var req pb.SubscribeToEventRequest
 
 	streamer, err := client.SubscribeToEvents(ctx, &req)
 	if err != nil {
@@ -93,14 +96,23 @@ func subscribeToEvents(ctx context.Context, client pb.NoteAPIClient) error {
 			log.Printf("server sent health check")
 		case *pb.SubscribeToEventResponse_CreatedNote:
 			log.Printf("response of created note: %v", r.CreatedNote)
+		case *pb.SubscribeToEventResponse_UpdatedNote:
+			log.Printf("response of updated note: %v", r.UpdatedNote)
+		case *pb.SubscribeToEventResponse_DeletedNote:
+			log.Printf("response of deleted note: %v", r.DeletedNote)
+		case *pb.SubscribeToEventResponse_RestoredNote:
+			log.Printf("response of restored note: %v", r.RestoredNote)
+		case *pb.SubscribeToEventResponse_SharedNote:
+			log.Printf("response of shared note: %v", r.SharedNote)
+		case *pb.SubscribeToEventResponse_PresenceJoined:
+			log.Printf("user joined note: %v", r.PresenceJoined)
+		case *pb.SubscribeToEventResponse_PresenceLeft:
+			log.Printf("user left note: %v", r.PresenceLeft)
 		}
 	}
 }
 
 func getNote(ctx context.Context, client pb.NoteAPIClient) {
-	md := metadata.New(map[string]string{"authorization": grpcx.MockToken})
-	ctx = metadata.NewOutgoingContext(ctx, md)
-
 	resp, err := client.GetNote(ctx, &pb.GetNoteRequest{NoteId: 1})
 	if err != nil {
 		if noteErr, ok := NoteError(err); ok {
@@ -122,8 +134,16 @@ func sendMetrics(ctx context.Context, client pb.NoteAPIClient) error {
 		return fmt.Errorf("send metrics: %v", err)
 	}
 
+	// a retried upload must reuse the batch id
+	batchID := uuid.NewString()
+
 	for i := range 10 {
-		if err := stream.Send(&pb.MetricsRequest{NoteViewCounter: int64(i)}); err != nil {
+		if err := stream.Send(&pb.MetricsRequest{
+			NoteId:          1,
+			NoteViewCounter: int64(i),
+			BatchId:         batchID,
+			Sequence:        int64(i + 1),
+		}); err != nil {
 			return err
 		}
 
@@ -168,6 +188,7 @@ func chatWithServer(ctx context.Context, client pb.NoteAPIClient) error {
 			clientMsg := pb.Message{
 				CorrelationId: correlationID,
 				Content:       msg,
+				NoteId:        1,
 			}
 
 			if err := stream.Send(&clientMsg); err != nil {
@@ -198,9 +219,15 @@ func chatWithServer(ctx context.Context, client pb.NoteAPIClient) error {
 			}
 
 			if msg.IsAck {
-				slogx.Info(ctx, "ack message", slog.String("correlation_id", msg.GetCorrelationId()))
+				slogx.Info(ctx, "ack message",
+					slog.String("correlation_id", msg.GetCorrelationId()),
+					slog.Int64("message_id", msg.GetMessageId()),
+				)
 			} else {
-				slogx.Info(ctx, "get message from server", slog.String("msg", msg.Content))
+				slogx.Info(ctx, "get message from server",
+					slog.String("msg", msg.Content),
+					slogx.UserId(msg.GetUserId()),
+				)
 			}
 		}
 	})
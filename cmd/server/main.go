@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"log"
 	"net/http"
@@ -21,16 +22,26 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	openapi "github.com/evgeniy-krivenko/grpc-notes/docs/api/notes/v1"
+	notebooksapi "github.com/evgeniy-krivenko/grpc-notes/internal/api/notebooks"
 	notesapi "github.com/evgeniy-krivenko/grpc-notes/internal/api/notes"
+	webhooksapi "github.com/evgeniy-krivenko/grpc-notes/internal/api/webhooks"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/broker"
 	"github.com/evgeniy-krivenko/grpc-notes/internal/config"
 	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/eventbus"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/purger"
 	"github.com/evgeniy-krivenko/grpc-notes/internal/repository"
+	notebooksusecase "github.com/evgeniy-krivenko/grpc-notes/internal/usecase/notebooks"
 	notesusecase "github.com/evgeniy-krivenko/grpc-notes/internal/usecase/notes"
+	webhooksusecase "github.com/evgeniy-krivenko/grpc-notes/internal/usecase/webhooks"
 	gw "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/blobstore"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/database"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/grpcx"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/gwserver"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/jwtx"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/webhook"
 	"github.com/evgeniy-krivenko/grpc-notes/third_party/swagger"
 )
 
@@ -64,7 +75,7 @@ func run() error {
 
 	logger := slogx.Default()
 
-	db, err := database.NewPGX(ctx,
+	pool, err := database.NewPGX(ctx,
 		database.NewOptions(
 			fmt.Sprintf("%s:%s", cfg.Database.Host, cfg.Database.Port),
 			cfg.Database.User,
@@ -77,18 +88,111 @@ func run() error {
 		return fmt.Errorf("init database: %v", err)
 	}
 
+	db := database.NewDatabase(pool)
 	repo := repository.New(db)
 
-	notesUsecase, err := notesusecase.New(notesusecase.NewOptions(repo))
+	blobs, err := blobstore.NewLocal(blobstore.NewOptions(cfg.Attachments.Dir))
+	if err != nil {
+		return fmt.Errorf("init blobstore: %v", err)
+	}
+
+	eventBus, err := eventbus.New(eventbus.NewOptions(repo, db, repository.NoteEventsChannel))
+	if err != nil {
+		return fmt.Errorf("init event bus: %v", err)
+	}
+
+	slowConsumerPolicy, err := broker.ParsePolicy(cfg.Events.SlowConsumerPolicy)
+	if err != nil {
+		return fmt.Errorf("parse slow consumer policy: %v", err)
+	}
+
+	eventBroker, err := broker.New(broker.NewOptions(
+		broker.WithQueueSize(cfg.Events.QueueSize),
+		broker.WithPolicy(slowConsumerPolicy),
+	))
+	if err != nil {
+		return fmt.Errorf("init event broker: %v", err)
+	}
+	expvar.Publish("note_event_broker", expvar.Func(func() any { return eventBroker.Stats() }))
+
+	notesUsecase, err := notesusecase.New(notesusecase.NewOptions(
+		repo,
+		db,
+		blobs,
+		eventBus,
+		eventBroker,
+		db,
+		repository.ChatMessagesChannel,
+		repository.NoteEditsChannel,
+		notesusecase.WithMaxAttachmentSize(cfg.Attachments.MaxSize),
+		notesusecase.WithMetricsBatchSize(cfg.Metrics.BatchSize),
+		notesusecase.WithSnapshotInterval(cfg.Editing.SnapshotInterval),
+		notesusecase.WithPresenceTTL(cfg.Presence.TTL),
+	))
 	if err != nil {
 		return fmt.Errorf("init notes usecase: %v", err)
 	}
 
+	trashPurger, err := purger.New(purger.NewOptions(
+		notesUsecase,
+		purger.WithRetention(cfg.Trash.Retention),
+		purger.WithInterval(cfg.Trash.PurgeInterval),
+		purger.WithMetricsRetention(cfg.Metrics.Retention),
+		purger.WithEditsRetention(cfg.Editing.Retention),
+		purger.WithEventsRetention(cfg.Events.Retention),
+	))
+	if err != nil {
+		return fmt.Errorf("init trash purger: %v", err)
+	}
+
 	notesSvc, err := notesapi.New(notesapi.NewOptions(notesUsecase))
 	if err != nil {
 		return fmt.Errorf("init notes api: %v", err)
 	}
 
+	notebooksUsecase, err := notebooksusecase.New(notebooksusecase.NewOptions(repo, db))
+	if err != nil {
+		return fmt.Errorf("init notebooks usecase: %v", err)
+	}
+
+	notebooksSvc, err := notebooksapi.New(notebooksapi.NewOptions(notebooksUsecase))
+	if err != nil {
+		return fmt.Errorf("init notebooks api: %v", err)
+	}
+
+	if cfg.Webhooks.Lease <= cfg.Webhooks.Timeout {
+		return fmt.Errorf("webhooks lease %s must be longer than the timeout %s", cfg.Webhooks.Lease, cfg.Webhooks.Timeout)
+	}
+
+	webhookSender, err := webhook.NewSender(webhook.NewOptions(
+		webhook.WithTimeout(cfg.Webhooks.Timeout),
+		webhook.WithAllowPrivateAddresses(cfg.Webhooks.AllowPrivateAddresses),
+	))
+	if err != nil {
+		return fmt.Errorf("init webhook sender: %v", err)
+	}
+
+	webhooksUsecase, err := webhooksusecase.New(webhooksusecase.NewOptions(
+		repo,
+		db,
+		webhookSender,
+		webhooksusecase.WithWorkers(cfg.Webhooks.Workers),
+		webhooksusecase.WithInterval(cfg.Webhooks.PollInterval),
+		webhooksusecase.WithLease(cfg.Webhooks.Lease),
+		webhooksusecase.WithRetryAttempts(cfg.Webhooks.RetryAttempts),
+		webhooksusecase.WithRetryDelay(cfg.Webhooks.RetryDelay),
+		webhooksusecase.WithRetryMaxDelay(cfg.Webhooks.RetryMaxDelay),
+		webhooksusecase.WithRetryMaxJitter(cfg.Webhooks.RetryMaxJitter),
+	))
+	if err != nil {
+		return fmt.Errorf("init webhooks usecase: %v", err)
+	}
+
+	webhooksSvc, err := webhooksapi.New(webhooksapi.NewOptions(webhooksUsecase))
+	if err != nil {
+		return fmt.Errorf("init webhooks api: %v", err)
+	}
+
 	gwSrv, err := buildGWServer(ctx, &cfg)
 	if err != nil {
 		return fmt.Errorf("build gateway server: %v", err)
@@ -99,19 +203,31 @@ func run() error {
 		return fmt.Errorf("build swagger server: %v", err)
 	}
 
+	verifier, err := buildVerifier(&cfg)
+	if err != nil {
+		return fmt.Errorf("build token verifier: %v", err)
+	}
+
+	policies := notesapi.Policies()
+
 	srv, err := grpcx.New(grpcx.NewOptions(
 		cfg.GRPC.Addr,
 		grpcx.WithLogger(logger),
-		grpcx.WithServices(notesSvc),
+		grpcx.WithServices(notesSvc, notebooksSvc, webhooksSvc),
 		grpcx.WithGrpcOptions(
 			grpc.ChainUnaryInterceptor(
-				ctxtr.MockAuthInterceptor(1),
-				grpcx.AuthInterceptor,
+				grpcx.AuthInterceptor(verifier, notesapi.PublicMethods()...),
+				ctxtr.UserInterceptor,
+				grpcx.PolicyInterceptor(policies),
 				slogx.LoggingInterceptor,
 				protovalidateic.UnaryServerInterceptor(validator),
 			),
 			grpc.ChainStreamInterceptor(
+				grpcx.StreamAuthInterceptor(verifier, notesapi.PublicMethods()...),
+				ctxtr.UserStreamInterceptor,
+				grpcx.StreamPolicyInterceptor(policies),
 				slogx.LoggingStreamInterceptor,
+				protovalidateic.StreamServerInterceptor(validator),
 			),
 			grpc.MaxConcurrentStreams(cfg.GRPC.MaxConcurrentStreams),
 			grpc.KeepaliveParams(keepalive.ServerParameters{
@@ -129,6 +245,12 @@ func run() error {
 	eg.Go(func() error { return srv.Run(ctx) })
 	eg.Go(func() error { return gwSrv.Run(ctx) })
 	eg.Go(func() error { return swaggerSrv.Run(ctx) })
+	eg.Go(func() error { return trashPurger.Run(ctx) })
+	eg.Go(func() error { return notesUsecase.RunEventBus(ctx) })
+	eg.Go(func() error { return notesUsecase.RunChatRelay(ctx) })
+	eg.Go(func() error { return notesUsecase.RunEditSessions(ctx) })
+	eg.Go(func() error { return notesUsecase.RunPresenceExpiry(ctx) })
+	eg.Go(func() error { return webhooksUsecase.RunDispatcher(ctx) })
 
 	if err := eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
 		return fmt.Errorf("wait app stop: %v", err)
@@ -138,21 +260,51 @@ func run() error {
 }
 
 func buildGWServer(ctx context.Context, cfg *config.Config) (*gwserver.Server, error) {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(notesapi.GatewayOptions()...)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	if err := gw.RegisterNoteAPIHandlerFromEndpoint(ctx, mux, cfg.GRPC.Addr, opts); err != nil {
 		return nil, fmt.Errorf("register grpc gateway: %v", err)
 	}
 
+	if err := gw.RegisterNotebookAPIHandlerFromEndpoint(ctx, mux, cfg.GRPC.Addr, opts); err != nil {
+		return nil, fmt.Errorf("register notebooks grpc gateway: %v", err)
+	}
+
+	if err := gw.RegisterWebhookAPIHandlerFromEndpoint(ctx, mux, cfg.GRPC.Addr, opts); err != nil {
+		return nil, fmt.Errorf("register webhooks grpc gateway: %v", err)
+	}
+
+	conn, err := grpc.NewClient(cfg.GRPC.Addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial grpc server: %v", err)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	notesClient := gw.NewNoteAPIClient(conn)
+
+	if err := notesapi.RegisterAttachmentUploadHandler(mux, notesClient); err != nil {
+		return nil, fmt.Errorf("register attachment upload handler: %v", err)
+	}
+
+	if err := notesapi.RegisterEventsHandler(mux, notesClient); err != nil {
+		return nil, fmt.Errorf("register events handler: %v", err)
+	}
+
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
 		AllowedMethods: []string{
 			http.MethodGet,
 			http.MethodPost,
 			http.MethodPut,
+			http.MethodPatch,
 			http.MethodDelete,
 		},
+		AllowedHeaders: []string{"Accept", "Content-Type", "X-Requested-With", "If-Match", "X-Share-Password", "Last-Event-ID"},
+		ExposedHeaders: []string{"ETag"},
 	})
 
 	wsMiddleware := func(h http.Handler) http.Handler {
@@ -167,6 +319,47 @@ func buildGWServer(ctx context.Context, cfg *config.Config) (*gwserver.Server, e
 	))
 }
 
+func buildVerifier(cfg *config.Config) (*jwtx.Verifier, error) {
+	var keys []jwtx.Key
+
+	if cfg.Auth.HMACSecret != "" {
+		keys = append(keys, jwtx.HMACKey([]byte(cfg.Auth.HMACSecret)))
+	}
+
+	if cfg.Auth.PublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.Auth.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read public key: %v", err)
+		}
+
+		key, err := jwtx.ParsePublicKeyPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if cfg.Auth.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.Auth.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwks: %v", err)
+		}
+
+		set, err := jwtx.ParseJWKS(data)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, set...)
+	}
+
+	return jwtx.NewVerifier(jwtx.NewOptions(
+		jwtx.WithKeys(keys...),
+		jwtx.WithIssuer(cfg.Auth.Issuer),
+		jwtx.WithAudience(cfg.Auth.Audience),
+		jwtx.WithLeeway(cfg.Auth.Leeway),
+	))
+}
+
 func buildSwaggerServer(cfg *config.Config) (*gwserver.Server, error) {
 	mux := http.NewServeMux()
 
@@ -176,6 +369,8 @@ func buildSwaggerServer(cfg *config.Config) (*gwserver.Server, error) {
 	swaggerSpecsHandler := http.StripPrefix("/swagger/specs", http.FileServer(http.FS(openapi.Content)))
 	mux.Handle("GET /swagger/specs/", swaggerSpecsHandler)
 
+	mux.Handle("GET /debug/vars", expvar.Handler())
+
 	return gwserver.New(gwserver.NewOptions(
 		cfg.SwaggerHTTP.Addr,
 		mux,
@@ -0,0 +1,163 @@
+package notebooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter/generated"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/grpcx"
+)
+
+var _ grpcx.Service = (*Service)(nil)
+
+var conv converter.Converter = &generated.ConverterImpl{}
+
+type notebooksUsecase interface {
+	CreateNotebook(ctx context.Context, userID, parentID int64, name string) (entity.Notebook, error)
+	GetNotebook(ctx context.Context, userID, id int64) (entity.Notebook, error)
+	ListNotebooks(ctx context.Context, userID, parentID int64) ([]entity.Notebook, error)
+	UpdateNotebook(ctx context.Context, userID, id int64, upd entity.NotebookUpdate) (entity.Notebook, error)
+	DeleteNotebook(ctx context.Context, userID, id int64) error
+	GetNotebookTree(ctx context.Context, userID, id int64) ([]entity.NotebookTree, error)
+}
+
+//go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.33.2 -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	usecase notebooksUsecase `option:"mandatory" validate:"required"`
+}
+
+type Service struct {
+	v1.UnimplementedNotebookAPIServer
+	Options
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate notebooks service options: %v", err)
+	}
+
+	return &Service{Options: opts}, nil
+}
+
+func (s *Service) RegisterService(srv grpc.ServiceRegistrar) {
+	v1.RegisterNotebookAPIServer(srv, s)
+}
+
+func (s *Service) CreateNotebook(ctx context.Context, req *v1.CreateNotebookRequest) (*v1.CreateNotebookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "create notebook: %v", err)
+	}
+
+	nb, err := s.usecase.CreateNotebook(ctx, userID, req.GetParentId(), req.GetName())
+	if err != nil {
+		if errors.Is(err, entity.ErrNotebookNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "parent notebook not found")
+		}
+		return nil, status.Errorf(codes.Internal, "create notebook: %v", err)
+	}
+
+	return &v1.CreateNotebookResponse{
+		Notebook: conv.ConvertNotebookToProto(nb),
+	}, nil
+}
+
+func (s *Service) GetNotebook(ctx context.Context, req *v1.GetNotebookRequest) (*v1.GetNotebookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get notebook: %v", err)
+	}
+
+	nb, err := s.usecase.GetNotebook(ctx, userID, req.GetNotebookId())
+	if err != nil {
+		return nil, notebookError("get notebook", err)
+	}
+
+	return &v1.GetNotebookResponse{
+		Notebook: conv.ConvertNotebookToProto(nb),
+	}, nil
+}
+
+func (s *Service) ListNotebooks(ctx context.Context, req *v1.ListNotebooksRequest) (*v1.ListNotebooksResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list notebooks: %v", err)
+	}
+
+	notebooks, err := s.usecase.ListNotebooks(ctx, userID, req.GetParentId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list notebooks: %v", err)
+	}
+
+	return &v1.ListNotebooksResponse{
+		Notebooks: conv.ConvertNotebooksToProto(notebooks),
+	}, nil
+}
+
+func (s *Service) UpdateNotebook(ctx context.Context, req *v1.UpdateNotebookRequest) (*v1.UpdateNotebookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "update notebook: %v", err)
+	}
+
+	nb, err := s.usecase.UpdateNotebook(ctx, userID, req.GetNotebookId(), entity.NotebookUpdate{
+		Name:     req.Name,
+		ParentID: req.ParentId,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrNotebookCycle) {
+			return nil, status.Error(codes.InvalidArgument, "notebook cannot be moved into its own subtree")
+		}
+		return nil, notebookError("update notebook", err)
+	}
+
+	return &v1.UpdateNotebookResponse{
+		Notebook: conv.ConvertNotebookToProto(nb),
+	}, nil
+}
+
+func (s *Service) DeleteNotebook(ctx context.Context, req *v1.DeleteNotebookRequest) (*v1.DeleteNotebookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "delete notebook: %v", err)
+	}
+
+	if err := s.usecase.DeleteNotebook(ctx, userID, req.GetNotebookId()); err != nil {
+		return nil, notebookError("delete notebook", err)
+	}
+
+	return &v1.DeleteNotebookResponse{}, nil
+}
+
+func (s *Service) GetNotebookTree(ctx context.Context, req *v1.GetNotebookTreeRequest) (*v1.GetNotebookTreeResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get notebook tree: %v", err)
+	}
+
+	roots, err := s.usecase.GetNotebookTree(ctx, userID, req.GetNotebookId())
+	if err != nil {
+		return nil, notebookError("get notebook tree", err)
+	}
+
+	return &v1.GetNotebookTreeResponse{
+		Roots: conv.ConvertNotebookTreesToProto(roots),
+	}, nil
+}
+
+func notebookError(op string, err error) error {
+	if errors.Is(err, entity.ErrNotebookNotFound) {
+		return status.Error(codes.NotFound, "notebook not found")
+	}
+
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
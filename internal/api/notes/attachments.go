@@ -0,0 +1,164 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const attachmentChunkSize = 64 << 10
+
+var errUnexpectedMetadata = errors.New("attachment metadata is expected in the first message only")
+
+func (s *Service) UploadAttachment(stream v1.NoteAPI_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "upload attachment: %v", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "attachment metadata is required")
+		}
+		return err
+	}
+
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "first message must carry attachment metadata")
+	}
+
+	attachment, err := s.usecase.UploadAttachment(ctx, userID, entity.Attachment{
+		NoteID:      meta.GetNoteId(),
+		Filename:    meta.GetFilename(),
+		ContentType: meta.GetContentType(),
+		Size:        meta.GetSize(),
+	}, &chunkReader{stream: stream})
+	if err != nil {
+		return attachmentError("upload attachment", err)
+	}
+
+	return stream.SendAndClose(&v1.UploadAttachmentResponse{
+		Attachment: conv.ConvertAttachmentToProto(attachment),
+	})
+}
+
+func (s *Service) DownloadAttachment(
+	req *v1.DownloadAttachmentRequest,
+	stream v1.NoteAPI_DownloadAttachmentServer,
+) error {
+	ctx := stream.Context()
+
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "download attachment: %v", err)
+	}
+
+	attachment, content, err := s.usecase.OpenAttachment(ctx, userID, req.GetNoteId(), req.GetAttachmentId())
+	if err != nil {
+		return attachmentError("download attachment", err)
+	}
+	defer content.Close()
+
+	if err := stream.Send(&v1.DownloadAttachmentResponse{
+		Data: &v1.DownloadAttachmentResponse_Attachment{
+			Attachment: conv.ConvertAttachmentToProto(attachment),
+		},
+	}); err != nil {
+		return err
+	}
+
+	for {
+		// A fresh buffer per message, sent messages may be read lazily.
+		chunk := make([]byte, attachmentChunkSize)
+
+		n, err := io.ReadFull(content, chunk)
+		if n > 0 {
+			if err := stream.Send(&v1.DownloadAttachmentResponse{
+				Data: &v1.DownloadAttachmentResponse_Chunk{Chunk: chunk[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			slogx.Debug(ctx, "success to download attachment", slogx.UserId(userID))
+			return nil
+		case err != nil:
+			return status.Errorf(codes.Internal, "download attachment: read content: %v", err)
+		}
+	}
+}
+
+func (s *Service) ListAttachments(ctx context.Context, req *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list attachments: %v", err)
+	}
+
+	attachments, err := s.usecase.ListAttachments(ctx, userID, req.GetNoteId())
+	if err != nil {
+		return nil, attachmentError("list attachments", err)
+	}
+
+	return &v1.ListAttachmentsResponse{
+		Attachments: conv.ConvertAttachmentsToProto(attachments),
+	}, nil
+}
+
+func attachmentError(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, "attachment not found")
+	case errors.Is(err, entity.ErrAttachmentTooLarge):
+		return withNoteError(
+			codes.InvalidArgument,
+			"attachment is too large",
+			v1.ErrorCode_ERROR_CODE_ATTACHMENT_TOO_LARGE,
+		)
+	case errors.Is(err, entity.ErrAttachmentSizeMismatch):
+		return status.Error(codes.InvalidArgument, entity.ErrAttachmentSizeMismatch.Error())
+	case errors.Is(err, errUnexpectedMetadata):
+		return status.Error(codes.InvalidArgument, errUnexpectedMetadata.Error())
+	default:
+		return noteError(op, err)
+	}
+}
+
+// chunkReader reads the attachment content from the upload stream.
+type chunkReader struct {
+	stream v1.NoteAPI_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetMetadata() != nil {
+			return 0, errUnexpectedMetadata
+		}
+
+		r.buf = req.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
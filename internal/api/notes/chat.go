@@ -0,0 +1,197 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func (s *Service) Chat(stream v1.NoteAPI_ChatServer) error {
+	ctx := stream.Context()
+
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "chat: %v", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	noteID := first.GetNoteId()
+	if noteID == 0 {
+		return status.Error(codes.InvalidArgument, "chat: the first message must select a note")
+	}
+
+	room, err := s.usecase.JoinChat(ctx, userID, noteID)
+	if err != nil {
+		return noteError("chat", err)
+	}
+
+	p, err := s.enterNotes(ctx, userID, []int64{noteID}, entity.PresenceChatting)
+	if err != nil {
+		return noteError("chat", err)
+	}
+	defer p.leave(ctx)
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	// Only this goroutine sends, the receiving one hands acks over. It is not
+	// waited for: Recv returns once the handler ends and the stream is done.
+	acks := make(chan *v1.ServerMessage)
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveChat(ctx, stream, userID, noteID, first, acks)
+	}()
+
+	for {
+		var msg *v1.ServerMessage
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err := <-recvErr:
+			if err != nil {
+				return err
+			}
+			// The client has stopped sending, but still reads the room.
+			recvErr = nil
+			continue
+
+		case <-ticker.C:
+			p.heartbeat(ctx)
+			continue
+
+		case msg = <-acks:
+
+		case m, ok := <-room.Messages():
+			if !ok {
+				return chatRoomError(ctx, room.Err())
+			}
+			msg = chatMessageToProto(m)
+		}
+
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// receiveChat posts client messages to the room and acks them with the saved
+// message id. It returns nil when the client closes its side of the stream.
+func (s *Service) receiveChat(
+	ctx context.Context,
+	stream v1.NoteAPI_ChatServer,
+	userID, noteID int64,
+	msg *v1.Message,
+	acks chan<- *v1.ServerMessage,
+) error {
+	for {
+		if msg.GetNoteId() != 0 && msg.GetNoteId() != noteID {
+			return status.Error(codes.InvalidArgument, "chat: the note cannot be changed")
+		}
+
+		if msg.GetContent() != "" {
+			m, err := s.usecase.PostChatMessage(ctx, entity.ChatMessage{
+				NoteID:  noteID,
+				UserID:  userID,
+				Content: msg.GetContent(),
+			})
+			if err != nil {
+				return noteError("chat", err)
+			}
+
+			ack := chatMessageToProto(m)
+			ack.CorrelationId = msg.GetCorrelationId()
+			ack.IsAck = true
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case acks <- ack:
+			}
+		}
+
+		var err error
+		msg, err = stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				slogx.Info(ctx, "client closed chat stream")
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (s *Service) ListChatMessages(ctx context.Context, req *v1.ListChatMessagesRequest) (*v1.ListChatMessagesResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list chat messages: %v", err)
+	}
+
+	q := entity.ChatMessagesQuery{
+		UserID:   userID,
+		NoteID:   req.GetNoteId(),
+		PageSize: int(req.GetPageSize()),
+	}
+
+	q.Before, err = decodeChatPageToken(req.GetPageToken(), q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "list chat messages: %v", err)
+	}
+
+	page, err := s.usecase.ListChatMessages(ctx, q)
+	if err != nil {
+		return nil, noteError("list chat messages", err)
+	}
+
+	nextPageToken, err := encodeChatPageToken(q, page.Next)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list chat messages: %v", err)
+	}
+
+	return &v1.ListChatMessagesResponse{
+		Messages:      conv.ConvertChatMessagesToProto(page.Messages),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// chatRoomError asks clients to rejoin and read the missed messages
+// from the history when they are removed from the room.
+func chatRoomError(ctx context.Context, err error) error {
+	switch {
+	case ctx.Err() != nil:
+		return nil
+	case errors.Is(err, entity.ErrSlowChatParticipant):
+		return status.Error(codes.ResourceExhausted, "chat participant is too slow, rejoin and list missed messages")
+	default:
+		return status.Error(codes.Unavailable, "chat room closed, rejoin and list missed messages")
+	}
+}
+
+func chatMessageToProto(m entity.ChatMessage) *v1.ServerMessage {
+	return &v1.ServerMessage{
+		Content:   m.Content,
+		MessageId: m.ID,
+		NoteId:    m.NoteID,
+		UserId:    m.UserID,
+		CreatedAt: converter.ConvertTimeToDateTime(m.CreatedAt),
+	}
+}
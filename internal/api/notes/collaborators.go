@@ -0,0 +1,69 @@
+package notes
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+func (s *Service) ShareNote(ctx context.Context, req *v1.ShareNoteRequest) (*v1.ShareNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "share note: %v", err)
+	}
+
+	collaborator, err := s.usecase.ShareNote(ctx, userID, entity.Collaborator{
+		NoteID: req.GetNoteId(),
+		UserID: req.GetUserId(),
+		Role:   converter.ConvertNoteRoleFromProto(req.GetRole()),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrShareWithOwner) {
+			return nil, status.Error(codes.InvalidArgument, "note cannot be shared with its owner")
+		}
+		return nil, noteError("share note", err)
+	}
+
+	return &v1.ShareNoteResponse{
+		Collaborator: conv.ConvertCollaboratorToProto(collaborator),
+	}, nil
+}
+
+func (s *Service) UnshareNote(ctx context.Context, req *v1.UnshareNoteRequest) (*v1.UnshareNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "unshare note: %v", err)
+	}
+
+	if err := s.usecase.UnshareNote(ctx, userID, req.GetNoteId(), req.GetUserId()); err != nil {
+		if errors.Is(err, entity.ErrCollaboratorNotFound) {
+			return nil, status.Error(codes.NotFound, "collaborator not found")
+		}
+		return nil, noteError("unshare note", err)
+	}
+
+	return &v1.UnshareNoteResponse{}, nil
+}
+
+func (s *Service) ListCollaborators(ctx context.Context, req *v1.ListCollaboratorsRequest) (*v1.ListCollaboratorsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list collaborators: %v", err)
+	}
+
+	collaborators, err := s.usecase.ListCollaborators(ctx, userID, req.GetNoteId())
+	if err != nil {
+		return nil, noteError("list collaborators", err)
+	}
+
+	return &v1.ListCollaboratorsResponse{
+		Collaborators: conv.ConvertCollaboratorsToProto(collaborators),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"encoding/hex"
 	"time"
 
 	"google.golang.org/genproto/googleapis/type/datetime"
@@ -14,6 +15,10 @@ import (
 // goverter:output:package generated
 // goverter:extend ConvertTimeToDateTime
 // goverter:extend ConvertDateTimeToTime
+// goverter:extend ConvertNoteRoleToProto
+// goverter:extend ConvertNoteEventTypeToProto
+// goverter:extend ConvertWebhookDeliveryStatusToProto
+// goverter:extend ConvertIntToInt32
 // goverter:skipCopySameType
 //go:generate go run github.com/jmattheis/goverter/cmd/goverter@v1.7.0 gen .
 type Converter interface {
@@ -21,9 +26,76 @@ type Converter interface {
 	// goverter:map UserID UserId
 	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
 	// goverter:map UpdatedAt UpdatedAt | ConvertTimeToDateTime
+	// goverter:map DeletedAt DeletedAt | ConvertTimeToDateTime
 	ConvertNoteToProto(note entity.Note) *v1.Note
 
 	ConvertNotesToProto(notes []entity.Note) []*v1.Note
+
+	// goverter:ignore NoteID
+	// goverter:map UserID UserId
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	ConvertCollaboratorToProto(c entity.Collaborator) *v1.Collaborator
+	ConvertCollaboratorsToProto(cs []entity.Collaborator) []*v1.Collaborator
+
+	// goverter:map ID Id
+	// goverter:map NoteID NoteId
+	// goverter:map SHA256 Sha256 | ConvertChecksumToHex
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	// goverter:ignore StorageKey
+	ConvertAttachmentToProto(a entity.Attachment) *v1.Attachment
+	ConvertAttachmentsToProto(as []entity.Attachment) []*v1.Attachment
+
+	// goverter:ignore ID
+	ConvertTagToProto(tag entity.Tag) *v1.Tag
+	ConvertTagsToProto(tags []entity.Tag) []*v1.Tag
+
+	// goverter:map NoteID NoteId
+	// goverter:map AuthorID AuthorId
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	ConvertNoteRevisionToProto(rev entity.NoteRevision) *v1.NoteRevision
+
+	ConvertNoteRevisionsToProto(revs []entity.NoteRevision) []*v1.NoteRevision
+
+	// goverter:map ID Id
+	// goverter:map UserID UserId
+	// goverter:map ParentID ParentId
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	// goverter:map UpdatedAt UpdatedAt | ConvertTimeToDateTime
+	// goverter:ignore Path
+	ConvertNotebookToProto(nb entity.Notebook) *v1.Notebook
+	ConvertNotebooksToProto(nbs []entity.Notebook) []*v1.Notebook
+
+	ConvertNotebookTreeToProto(tree entity.NotebookTree) *v1.NotebookTree
+	ConvertNotebookTreesToProto(trees []entity.NotebookTree) []*v1.NotebookTree
+
+	// goverter:map ID Id
+	// goverter:map URL Url
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	// goverter:ignore UserID Secret
+	ConvertWebhookToProto(w entity.Webhook) *v1.Webhook
+	ConvertWebhooksToProto(ws []entity.Webhook) []*v1.Webhook
+
+	// goverter:map ID Id
+	// goverter:map WebhookID WebhookId
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	// goverter:map CompletedAt CompletedAt | ConvertTimeToDateTime
+	// goverter:ignore Payload
+	ConvertWebhookDeliveryToProto(d entity.WebhookDelivery) *v1.WebhookDelivery
+	ConvertWebhookDeliveriesToProto(ds []entity.WebhookDelivery) []*v1.WebhookDelivery
+
+	// goverter:map Start Start | ConvertTimeToDateTime
+	ConvertViewBucketToProto(b entity.ViewBucket) *v1.ViewBucket
+	ConvertViewBucketsToProto(bs []entity.ViewBucket) []*v1.ViewBucket
+
+	ConvertNoteViewsToProto(nv entity.NoteViews) *v1.NoteViews
+	ConvertNoteViewsListToProto(nvs []entity.NoteViews) []*v1.NoteViews
+
+	// goverter:map ID Id
+	// goverter:map NoteID NoteId
+	// goverter:map UserID UserId
+	// goverter:map CreatedAt CreatedAt | ConvertTimeToDateTime
+	ConvertChatMessageToProto(m entity.ChatMessage) *v1.ChatMessage
+	ConvertChatMessagesToProto(ms []entity.ChatMessage) []*v1.ChatMessage
 }
 
 func ConvertTimeToDateTime(t time.Time) *datetime.DateTime {
@@ -58,3 +130,114 @@ func ConvertDateTimeToTime(dt *datetime.DateTime) time.Time {
 		time.UTC,
 	)
 }
+
+func ConvertNoteRoleToProto(role entity.NoteRole) v1.NoteRole {
+	switch role {
+	case entity.NoteRoleViewer:
+		return v1.NoteRole_NOTE_ROLE_VIEWER
+	case entity.NoteRoleEditor:
+		return v1.NoteRole_NOTE_ROLE_EDITOR
+	case entity.NoteRoleOwner:
+		return v1.NoteRole_NOTE_ROLE_OWNER
+	default:
+		return v1.NoteRole_NOTE_ROLE_NONE
+	}
+}
+
+func ConvertNoteRoleFromProto(role v1.NoteRole) entity.NoteRole {
+	switch role {
+	case v1.NoteRole_NOTE_ROLE_VIEWER:
+		return entity.NoteRoleViewer
+	case v1.NoteRole_NOTE_ROLE_EDITOR:
+		return entity.NoteRoleEditor
+	case v1.NoteRole_NOTE_ROLE_OWNER:
+		return entity.NoteRoleOwner
+	default:
+		return entity.NoteRoleNone
+	}
+}
+
+func ConvertNoteEventTypeToProto(t entity.NoteEventType) v1.NoteEventType {
+	switch t {
+	case entity.NoteEventCreated:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_CREATED
+	case entity.NoteEventUpdated:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_UPDATED
+	case entity.NoteEventDeleted:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_DELETED
+	case entity.NoteEventRestored:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_RESTORED
+	case entity.NoteEventShared:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_SHARED
+	case entity.NoteEventPresenceJoined:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_PRESENCE_JOINED
+	case entity.NoteEventPresenceLeft:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_PRESENCE_LEFT
+	default:
+		return v1.NoteEventType_NOTE_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func ConvertNoteEventTypeFromProto(t v1.NoteEventType) entity.NoteEventType {
+	switch t {
+	case v1.NoteEventType_NOTE_EVENT_TYPE_CREATED:
+		return entity.NoteEventCreated
+	case v1.NoteEventType_NOTE_EVENT_TYPE_UPDATED:
+		return entity.NoteEventUpdated
+	case v1.NoteEventType_NOTE_EVENT_TYPE_DELETED:
+		return entity.NoteEventDeleted
+	case v1.NoteEventType_NOTE_EVENT_TYPE_RESTORED:
+		return entity.NoteEventRestored
+	case v1.NoteEventType_NOTE_EVENT_TYPE_SHARED:
+		return entity.NoteEventShared
+	case v1.NoteEventType_NOTE_EVENT_TYPE_PRESENCE_JOINED:
+		return entity.NoteEventPresenceJoined
+	case v1.NoteEventType_NOTE_EVENT_TYPE_PRESENCE_LEFT:
+		return entity.NoteEventPresenceLeft
+	default:
+		return 0
+	}
+}
+
+func ConvertPresenceActivityToProto(a entity.PresenceActivity) v1.PresenceActivity {
+	switch a {
+	case entity.PresenceViewing:
+		return v1.PresenceActivity_PRESENCE_ACTIVITY_VIEWING
+	case entity.PresenceChatting:
+		return v1.PresenceActivity_PRESENCE_ACTIVITY_CHATTING
+	case entity.PresenceEditing:
+		return v1.PresenceActivity_PRESENCE_ACTIVITY_EDITING
+	default:
+		return v1.PresenceActivity_PRESENCE_ACTIVITY_UNSPECIFIED
+	}
+}
+
+func ConvertWebhookDeliveryStatusToProto(s entity.WebhookDeliveryStatus) v1.WebhookDeliveryStatus {
+	switch s {
+	case entity.WebhookDeliveryPending:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case entity.WebhookDeliverySucceeded:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case entity.WebhookDeliveryFailed:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func ConvertStatsGranularityFromProto(g v1.StatsGranularity) entity.StatsGranularity {
+	switch g {
+	case v1.StatsGranularity_STATS_GRANULARITY_DAY:
+		return entity.StatsGranularityDay
+	default:
+		return entity.StatsGranularityHour
+	}
+}
+
+func ConvertIntToInt32(i int) int32 {
+	return int32(i)
+}
+
+func ConvertChecksumToHex(sum []byte) string {
+	return hex.EncodeToString(sum)
+}
@@ -14,7 +14,11 @@ func (c *ConverterImpl) ConvertNoteToProto(note entity.Note) *v1.Note {
 	var pNote v1.Note
 	pNote.Content = note.Content
 	pNote.CreatedAt = converter.ConvertTimeToDateTime(note.CreatedAt)
+	pNote.DeletedAt = converter.ConvertTimeToDateTime(note.DeletedAt)
 	pNote.Id = note.ID
+	pNote.NotebookId = note.NotebookID
+	pNote.Revision = note.Revision
+	pNote.Tags = note.Tags
 	pNote.Title = note.Title
 	pNote.UpdatedAt = converter.ConvertTimeToDateTime(note.UpdatedAt)
 	pNote.UserId = note.UserID
@@ -30,3 +34,217 @@ func (c *ConverterImpl) ConvertNotesToProto(notes []entity.Note) []*v1.Note {
 	}
 	return pNotes
 }
+func (c *ConverterImpl) ConvertCollaboratorToProto(collaborator entity.Collaborator) *v1.Collaborator {
+	var pCollaborator v1.Collaborator
+	pCollaborator.CreatedAt = converter.ConvertTimeToDateTime(collaborator.CreatedAt)
+	pCollaborator.Role = converter.ConvertNoteRoleToProto(collaborator.Role)
+	pCollaborator.UserId = collaborator.UserID
+	return &pCollaborator
+}
+func (c *ConverterImpl) ConvertCollaboratorsToProto(cs []entity.Collaborator) []*v1.Collaborator {
+	var pCollaborators []*v1.Collaborator
+	if cs != nil {
+		pCollaborators = make([]*v1.Collaborator, len(cs))
+		for i := 0; i < len(cs); i++ {
+			pCollaborators[i] = c.ConvertCollaboratorToProto(cs[i])
+		}
+	}
+	return pCollaborators
+}
+func (c *ConverterImpl) ConvertAttachmentToProto(a entity.Attachment) *v1.Attachment {
+	var pAttachment v1.Attachment
+	pAttachment.ContentType = a.ContentType
+	pAttachment.CreatedAt = converter.ConvertTimeToDateTime(a.CreatedAt)
+	pAttachment.CreatedBy = a.CreatedBy
+	pAttachment.Filename = a.Filename
+	pAttachment.Id = a.ID
+	pAttachment.NoteId = a.NoteID
+	pAttachment.Sha256 = converter.ConvertChecksumToHex(a.SHA256)
+	pAttachment.Size = a.Size
+	return &pAttachment
+}
+func (c *ConverterImpl) ConvertAttachmentsToProto(as []entity.Attachment) []*v1.Attachment {
+	var pAttachments []*v1.Attachment
+	if as != nil {
+		pAttachments = make([]*v1.Attachment, len(as))
+		for i := 0; i < len(as); i++ {
+			pAttachments[i] = c.ConvertAttachmentToProto(as[i])
+		}
+	}
+	return pAttachments
+}
+func (c *ConverterImpl) ConvertTagToProto(tag entity.Tag) *v1.Tag {
+	var pTag v1.Tag
+	pTag.Name = tag.Name
+	pTag.NoteCount = tag.NoteCount
+	return &pTag
+}
+func (c *ConverterImpl) ConvertTagsToProto(tags []entity.Tag) []*v1.Tag {
+	var pTags []*v1.Tag
+	if tags != nil {
+		pTags = make([]*v1.Tag, len(tags))
+		for i := 0; i < len(tags); i++ {
+			pTags[i] = c.ConvertTagToProto(tags[i])
+		}
+	}
+	return pTags
+}
+func (c *ConverterImpl) ConvertNoteRevisionToProto(rev entity.NoteRevision) *v1.NoteRevision {
+	var pNoteRevision v1.NoteRevision
+	pNoteRevision.AuthorId = rev.AuthorID
+	pNoteRevision.Content = rev.Content
+	pNoteRevision.CreatedAt = converter.ConvertTimeToDateTime(rev.CreatedAt)
+	pNoteRevision.NoteId = rev.NoteID
+	pNoteRevision.Revision = rev.Revision
+	pNoteRevision.Title = rev.Title
+	return &pNoteRevision
+}
+func (c *ConverterImpl) ConvertNoteRevisionsToProto(revs []entity.NoteRevision) []*v1.NoteRevision {
+	var pNoteRevisions []*v1.NoteRevision
+	if revs != nil {
+		pNoteRevisions = make([]*v1.NoteRevision, len(revs))
+		for i := 0; i < len(revs); i++ {
+			pNoteRevisions[i] = c.ConvertNoteRevisionToProto(revs[i])
+		}
+	}
+	return pNoteRevisions
+}
+func (c *ConverterImpl) ConvertNotebookToProto(nb entity.Notebook) *v1.Notebook {
+	var pNotebook v1.Notebook
+	pNotebook.CreatedAt = converter.ConvertTimeToDateTime(nb.CreatedAt)
+	pNotebook.Id = nb.ID
+	pNotebook.Name = nb.Name
+	pNotebook.ParentId = nb.ParentID
+	pNotebook.UpdatedAt = converter.ConvertTimeToDateTime(nb.UpdatedAt)
+	pNotebook.UserId = nb.UserID
+	return &pNotebook
+}
+func (c *ConverterImpl) ConvertNotebooksToProto(nbs []entity.Notebook) []*v1.Notebook {
+	var pNotebooks []*v1.Notebook
+	if nbs != nil {
+		pNotebooks = make([]*v1.Notebook, len(nbs))
+		for i := 0; i < len(nbs); i++ {
+			pNotebooks[i] = c.ConvertNotebookToProto(nbs[i])
+		}
+	}
+	return pNotebooks
+}
+func (c *ConverterImpl) ConvertNotebookTreeToProto(tree entity.NotebookTree) *v1.NotebookTree {
+	var pNotebookTree v1.NotebookTree
+	pNotebookTree.Children = c.ConvertNotebookTreesToProto(tree.Children)
+	pNotebookTree.NoteCount = tree.NoteCount
+	pNotebookTree.Notebook = c.ConvertNotebookToProto(tree.Notebook)
+	pNotebookTree.TotalNoteCount = tree.TotalNoteCount
+	return &pNotebookTree
+}
+func (c *ConverterImpl) ConvertNotebookTreesToProto(trees []entity.NotebookTree) []*v1.NotebookTree {
+	var pNotebookTrees []*v1.NotebookTree
+	if trees != nil {
+		pNotebookTrees = make([]*v1.NotebookTree, len(trees))
+		for i := 0; i < len(trees); i++ {
+			pNotebookTrees[i] = c.ConvertNotebookTreeToProto(trees[i])
+		}
+	}
+	return pNotebookTrees
+}
+func (c *ConverterImpl) ConvertWebhookToProto(w entity.Webhook) *v1.Webhook {
+	var pWebhook v1.Webhook
+	pWebhook.CreatedAt = converter.ConvertTimeToDateTime(w.CreatedAt)
+	var v1NoteEventTypeList []v1.NoteEventType
+	if w.EventTypes != nil {
+		v1NoteEventTypeList = make([]v1.NoteEventType, len(w.EventTypes))
+		for i := 0; i < len(w.EventTypes); i++ {
+			v1NoteEventTypeList[i] = converter.ConvertNoteEventTypeToProto(w.EventTypes[i])
+		}
+	}
+	pWebhook.EventTypes = v1NoteEventTypeList
+	pWebhook.Id = w.ID
+	pWebhook.Url = w.URL
+	return &pWebhook
+}
+func (c *ConverterImpl) ConvertWebhooksToProto(ws []entity.Webhook) []*v1.Webhook {
+	var pWebhooks []*v1.Webhook
+	if ws != nil {
+		pWebhooks = make([]*v1.Webhook, len(ws))
+		for i := 0; i < len(ws); i++ {
+			pWebhooks[i] = c.ConvertWebhookToProto(ws[i])
+		}
+	}
+	return pWebhooks
+}
+func (c *ConverterImpl) ConvertWebhookDeliveryToProto(d entity.WebhookDelivery) *v1.WebhookDelivery {
+	var pWebhookDelivery v1.WebhookDelivery
+	pWebhookDelivery.Attempts = converter.ConvertIntToInt32(d.Attempts)
+	pWebhookDelivery.CompletedAt = converter.ConvertTimeToDateTime(d.CompletedAt)
+	pWebhookDelivery.CreatedAt = converter.ConvertTimeToDateTime(d.CreatedAt)
+	pWebhookDelivery.EventSequence = d.EventSequence
+	pWebhookDelivery.EventType = converter.ConvertNoteEventTypeToProto(d.EventType)
+	pWebhookDelivery.Id = d.ID
+	pWebhookDelivery.LastError = d.LastError
+	pWebhookDelivery.NextAttemptAt = converter.ConvertTimeToDateTime(d.NextAttemptAt)
+	pWebhookDelivery.ResponseStatus = converter.ConvertIntToInt32(d.ResponseStatus)
+	pWebhookDelivery.Status = converter.ConvertWebhookDeliveryStatusToProto(d.Status)
+	pWebhookDelivery.WebhookId = d.WebhookID
+	return &pWebhookDelivery
+}
+func (c *ConverterImpl) ConvertWebhookDeliveriesToProto(ds []entity.WebhookDelivery) []*v1.WebhookDelivery {
+	var pWebhookDeliveries []*v1.WebhookDelivery
+	if ds != nil {
+		pWebhookDeliveries = make([]*v1.WebhookDelivery, len(ds))
+		for i := 0; i < len(ds); i++ {
+			pWebhookDeliveries[i] = c.ConvertWebhookDeliveryToProto(ds[i])
+		}
+	}
+	return pWebhookDeliveries
+}
+func (c *ConverterImpl) ConvertViewBucketToProto(b entity.ViewBucket) *v1.ViewBucket {
+	var pViewBucket v1.ViewBucket
+	pViewBucket.Start = converter.ConvertTimeToDateTime(b.Start)
+	pViewBucket.Views = b.Views
+	return &pViewBucket
+}
+func (c *ConverterImpl) ConvertViewBucketsToProto(bs []entity.ViewBucket) []*v1.ViewBucket {
+	var pViewBuckets []*v1.ViewBucket
+	if bs != nil {
+		pViewBuckets = make([]*v1.ViewBucket, len(bs))
+		for i := 0; i < len(bs); i++ {
+			pViewBuckets[i] = c.ConvertViewBucketToProto(bs[i])
+		}
+	}
+	return pViewBuckets
+}
+func (c *ConverterImpl) ConvertNoteViewsToProto(nv entity.NoteViews) *v1.NoteViews {
+	var pNoteViews v1.NoteViews
+	pNoteViews.Note = c.ConvertNoteToProto(nv.Note)
+	pNoteViews.Views = nv.Views
+	return &pNoteViews
+}
+func (c *ConverterImpl) ConvertNoteViewsListToProto(nvs []entity.NoteViews) []*v1.NoteViews {
+	var pNoteViewsList []*v1.NoteViews
+	if nvs != nil {
+		pNoteViewsList = make([]*v1.NoteViews, len(nvs))
+		for i := 0; i < len(nvs); i++ {
+			pNoteViewsList[i] = c.ConvertNoteViewsToProto(nvs[i])
+		}
+	}
+	return pNoteViewsList
+}
+func (c *ConverterImpl) ConvertChatMessageToProto(m entity.ChatMessage) *v1.ChatMessage {
+	var pChatMessage v1.ChatMessage
+	pChatMessage.Content = m.Content
+	pChatMessage.CreatedAt = converter.ConvertTimeToDateTime(m.CreatedAt)
+	pChatMessage.Id = m.ID
+	pChatMessage.NoteId = m.NoteID
+	pChatMessage.UserId = m.UserID
+	return &pChatMessage
+}
+func (c *ConverterImpl) ConvertChatMessagesToProto(ms []entity.ChatMessage) []*v1.ChatMessage {
+	var pChatMessages []*v1.ChatMessage
+	if ms != nil {
+		pChatMessages = make([]*v1.ChatMessage, len(ms))
+		for i := 0; i < len(ms); i++ {
+			pChatMessages[i] = c.ConvertChatMessageToProto(ms[i])
+		}
+	}
+	return pChatMessages
+}
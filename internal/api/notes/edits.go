@@ -0,0 +1,311 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/ot"
+)
+
+// editAck is handed over by the receiving goroutine for every applied
+// operation of the client.
+type editAck struct {
+	version       int64
+	correlationID string
+}
+
+func (s *Service) EditSession(stream v1.NoteAPI_EditSessionServer) error {
+	ctx := stream.Context()
+
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "edit session: %v", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+
+	join := first.GetJoin()
+	if join == nil {
+		return status.Error(codes.InvalidArgument, "edit session: the first request must join a note")
+	}
+	noteID := join.GetNoteId()
+
+	snapshot, edits, err := s.usecase.JoinEditSession(ctx, userID, noteID)
+	if err != nil {
+		return editError("edit session", err)
+	}
+
+	p, err := s.enterNotes(ctx, userID, []int64{noteID}, entity.PresenceEditing)
+	if err != nil {
+		return editError("edit session", err)
+	}
+	defer p.leave(ctx)
+
+	if err := stream.Send(&v1.EditSessionResponse{
+		Response: &v1.EditSessionResponse_Snapshot{Snapshot: editSnapshotToProto(snapshot)},
+	}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	// Only this goroutine sends, the receiving one hands acks over. It is not
+	// waited for: Recv returns once the handler ends and the stream is done.
+	acks := make(chan editAck)
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveEdits(ctx, stream, userID, noteID, snapshot.SessionID, acks)
+	}()
+
+	// Operations of the client are acked when the session streams them,
+	// so acks and operations of others reach the client in version order.
+	applied := make(map[int64]string)
+
+	for {
+		var resp *v1.EditSessionResponse
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case err := <-recvErr:
+			if err != nil {
+				return err
+			}
+			// The client has stopped sending, but still follows the session.
+			recvErr = nil
+			continue
+
+		case <-ticker.C:
+			p.heartbeat(ctx)
+			continue
+
+		case ack := <-acks:
+			applied[ack.version] = ack.correlationID
+			continue
+
+		case e, ok := <-edits.Edits():
+			if !ok {
+				return editStreamError(ctx, edits.Err())
+			}
+
+			if e.Kind != entity.NoteEditOperation || e.SessionID != snapshot.SessionID {
+				resp = noteEditToProto(e)
+				break
+			}
+
+			// The operation may be streamed before its ack is handed over.
+			for {
+				if _, ok := applied[e.Version]; ok {
+					break
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case err := <-recvErr:
+					if err != nil {
+						return err
+					}
+					recvErr = nil
+				case ack := <-acks:
+					applied[ack.version] = ack.correlationID
+				}
+			}
+
+			resp = &v1.EditSessionResponse{
+				Response: &v1.EditSessionResponse_Ack{Ack: &v1.EditAck{
+					CorrelationId: applied[e.Version],
+					Version:       e.Version,
+				}},
+			}
+			delete(applied, e.Version)
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// receiveEdits applies operations and cursor moves of the client. It returns
+// nil when the client closes its side of the stream.
+func (s *Service) receiveEdits(
+	ctx context.Context,
+	stream v1.NoteAPI_EditSessionServer,
+	userID, noteID int64,
+	sessionID uuid.UUID,
+	acks chan<- editAck,
+) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				slogx.Info(ctx, "client closed edit session stream")
+				return nil
+			}
+			return err
+		}
+
+		e := entity.NoteEdit{
+			NoteID:    noteID,
+			SessionID: sessionID,
+			UserID:    userID,
+		}
+
+		switch r := req.GetRequest().(type) {
+		case *v1.EditSessionRequest_Join:
+			return status.Error(codes.InvalidArgument, "edit session: the note is already joined")
+
+		case *v1.EditSessionRequest_Operation:
+			e.Version = r.Operation.GetVersion()
+			e.Operation = editOperationFromProto(r.Operation.GetComponents())
+
+			e, err = s.usecase.ApplyEditOperation(ctx, e)
+			if err != nil {
+				return editError("edit session", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case acks <- editAck{version: e.Version, correlationID: req.GetCorrelationId()}:
+			}
+
+		case *v1.EditSessionRequest_Cursor:
+			e.Version = r.Cursor.GetVersion()
+			e.Cursor = entity.EditCursor{
+				Position:     int(r.Cursor.GetPosition()),
+				SelectionEnd: int(r.Cursor.GetSelectionEnd()),
+			}
+
+			if _, err := s.usecase.MoveEditCursor(ctx, e); err != nil {
+				return editError("edit session", err)
+			}
+		}
+	}
+}
+
+func editError(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidEditOperation), errors.Is(err, entity.ErrInvalidEditCursor):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	case errors.Is(err, entity.ErrEditVersionGone):
+		return status.Error(codes.FailedPrecondition, "edit version is gone, rejoin the session")
+	default:
+		return noteError(op, err)
+	}
+}
+
+// editStreamError asks clients to rejoin when they leave the session
+// before closing the stream.
+func editStreamError(ctx context.Context, err error) error {
+	switch {
+	case ctx.Err() != nil:
+		return nil
+	case errors.Is(err, entity.ErrSlowEditParticipant):
+		return status.Error(codes.ResourceExhausted, "edit session participant is too slow, rejoin the session")
+	default:
+		return status.Error(codes.Unavailable, "edit session closed, rejoin the session")
+	}
+}
+
+func editSnapshotToProto(snapshot entity.EditSnapshot) *v1.EditSnapshot {
+	cursors := make([]*v1.EditRemoteCursor, 0, len(snapshot.Cursors))
+	for _, c := range snapshot.Cursors {
+		cursors = append(cursors, editRemoteCursorToProto(c))
+	}
+
+	return &v1.EditSnapshot{
+		SessionId: snapshot.SessionID.String(),
+		Content:   snapshot.Content,
+		Version:   snapshot.Version,
+		Cursors:   cursors,
+	}
+}
+
+func noteEditToProto(e entity.NoteEdit) *v1.EditSessionResponse {
+	var resp v1.EditSessionResponse
+	switch e.Kind {
+	case entity.NoteEditOperation:
+		resp.Response = &v1.EditSessionResponse_Operation{Operation: &v1.EditRemoteOperation{
+			Participant: editParticipantToProto(e),
+			Operation: &v1.EditOperation{
+				Version:    e.Version,
+				Components: editOperationToProto(e.Operation),
+			},
+		}}
+	case entity.NoteEditCursor:
+		resp.Response = &v1.EditSessionResponse_Cursor{Cursor: editRemoteCursorToProto(e)}
+	case entity.NoteEditLeave:
+		resp.Response = &v1.EditSessionResponse_Left{Left: editParticipantToProto(e)}
+	}
+
+	return &resp
+}
+
+func editRemoteCursorToProto(e entity.NoteEdit) *v1.EditRemoteCursor {
+	return &v1.EditRemoteCursor{
+		Participant: editParticipantToProto(e),
+		Cursor: &v1.EditCursor{
+			Version:      e.Version,
+			Position:     int64(e.Cursor.Position),
+			SelectionEnd: int64(e.Cursor.SelectionEnd),
+		},
+	}
+}
+
+func editParticipantToProto(e entity.NoteEdit) *v1.EditParticipant {
+	return &v1.EditParticipant{
+		SessionId: e.SessionID.String(),
+		UserId:    e.UserID,
+	}
+}
+
+func editOperationFromProto(components []*v1.EditComponent) ot.Operation {
+	op := make(ot.Operation, 0, len(components))
+	for _, c := range components {
+		op = append(op, ot.Component{
+			Retain: int(c.GetRetain()),
+			Insert: c.GetInsert(),
+			Delete: int(c.GetDelete()),
+		})
+	}
+
+	return op
+}
+
+func editOperationToProto(op ot.Operation) []*v1.EditComponent {
+	components := make([]*v1.EditComponent, 0, len(op))
+	for _, c := range op {
+		var pc v1.EditComponent
+		switch {
+		case c.Retain > 0:
+			pc.Component = &v1.EditComponent_Retain{Retain: int64(c.Retain)}
+		case c.Insert != "":
+			pc.Component = &v1.EditComponent_Insert{Insert: c.Insert}
+		case c.Delete > 0:
+			pc.Component = &v1.EditComponent_Delete{Delete: int64(c.Delete)}
+		}
+		components = append(components, &pc)
+	}
+
+	return components
+}
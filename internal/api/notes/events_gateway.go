@@ -0,0 +1,214 @@
+package notes
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+const (
+	eventsPath        = "/v1/events"
+	lastEventIDHeader = "Last-Event-ID"
+	resumeAfterParam  = "resume_after_sequence"
+	openNoteIDsParam  = "open_note_ids"
+	// tokenCookie is the cookie the websocket proxy takes tokens from as well.
+	tokenCookie = "token"
+)
+
+// RegisterEventsHandler streams note events on GET /v1/events as
+// Server-Sent Events. Event ids are event sequences, so browsers resume
+// with the Last-Event-ID header after a reconnect. The first connection
+// may resume with the resume_after_sequence query parameter instead.
+// Repeated open_note_ids query parameters mark the user present on the notes
+// while the stream lasts.
+//
+// EventSource cannot set the Authorization header, the token is taken from
+// the token cookie when the header is missing. Query parameters are not
+// accepted, URLs end up in access logs and browser history.
+func RegisterEventsHandler(mux *runtime.ServeMux, client v1.NoteAPIClient) error {
+	return mux.HandlePath(http.MethodGet, eventsPath,
+		func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+
+			authorizeEventSource(r)
+
+			ctx, err := runtime.AnnotateContext(r.Context(), mux, r,
+				"/"+v1.NoteAPI_ServiceDesc.ServiceName+"/SubscribeToEvents",
+				runtime.WithHTTPPathPattern(eventsPath),
+			)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			resumeAfter, err := resumeAfterSequence(r)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			openNoteIDs, err := openNoteIDs(r)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			stream, err := client.SubscribeToEvents(ctx, &v1.SubscribeToEventRequest{
+				ResumeAfterSequence: resumeAfter,
+				OpenNoteIds:         openNoteIDs,
+			})
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			// The server starts with a health check, waiting for it turns
+			// a rejected subscription into a regular HTTP error.
+			resp, err := stream.Recv()
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+
+			rc := http.NewResponseController(w)
+			for {
+				if err := writeServerSentEvent(w, outbound, resp); err != nil {
+					return
+				}
+				if err := rc.Flush(); err != nil {
+					return
+				}
+
+				resp, err = stream.Recv()
+				if err != nil {
+					if ctx.Err() == nil {
+						// Browsers reconnect on their own with the last event id.
+						msg := status.Convert(err).Message()
+						if writeEventFrame(w, "", "error", []byte(msg)) == nil {
+							_ = rc.Flush()
+						}
+					}
+					return
+				}
+			}
+		})
+}
+
+// authorizeEventSource puts the token of the cookie into the Authorization
+// header, the header forwarded to the server.
+func authorizeEventSource(r *http.Request) {
+	if r.Header.Get("Authorization") != "" {
+		return
+	}
+
+	if c, err := r.Cookie(tokenCookie); err == nil && c.Value != "" {
+		r.Header.Set("Authorization", "Bearer "+c.Value)
+	}
+}
+
+func resumeAfterSequence(r *http.Request) (int64, error) {
+	raw := r.Header.Get(lastEventIDHeader)
+	if raw == "" {
+		raw = r.URL.Query().Get(resumeAfterParam)
+	}
+	if raw == "" {
+		return 0, nil
+	}
+
+	sequence, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid event id %q", raw)
+	}
+
+	return sequence, nil
+}
+
+func openNoteIDs(r *http.Request) ([]int64, error) {
+	raw := r.URL.Query()[openNoteIDsParam]
+
+	ids := make([]int64, 0, len(raw))
+	for _, s := range raw {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid note id %q", s)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// writeServerSentEvent writes a note event, health checks become
+// heartbeat comments that keep proxies from closing an idle connection.
+func writeServerSentEvent(w io.Writer, marshaler runtime.Marshaler, resp *v1.SubscribeToEventResponse) error {
+	var (
+		name string
+		data proto.Message
+	)
+	switch r := resp.GetResult().(type) {
+	case *v1.SubscribeToEventResponse_HealthCheck:
+		ts := converter.ConvertDateTimeToTime(r.HealthCheck.GetTimestamp())
+		_, err := fmt.Fprintf(w, ": heartbeat %s\n\n", ts.Format(time.RFC3339))
+		return err
+	case *v1.SubscribeToEventResponse_CreatedNote:
+		name, data = "created", r.CreatedNote
+	case *v1.SubscribeToEventResponse_UpdatedNote:
+		name, data = "updated", r.UpdatedNote
+	case *v1.SubscribeToEventResponse_DeletedNote:
+		name, data = "deleted", r.DeletedNote
+	case *v1.SubscribeToEventResponse_RestoredNote:
+		name, data = "restored", r.RestoredNote
+	case *v1.SubscribeToEventResponse_SharedNote:
+		name, data = "shared", r.SharedNote
+	case *v1.SubscribeToEventResponse_PresenceJoined:
+		name, data = "presence_joined", r.PresenceJoined
+	case *v1.SubscribeToEventResponse_PresenceLeft:
+		name, data = "presence_left", r.PresenceLeft
+	default:
+		return nil
+	}
+
+	payload, err := marshaler.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return writeEventFrame(w, strconv.FormatInt(resp.GetSequence(), 10), name, bytes.TrimSpace(payload))
+}
+
+// writeEventFrame writes an event, the id is omitted when empty. Every line
+// of data goes to its own data field, so line breaks in data cannot end
+// the event early or add fields to it.
+func writeEventFrame(w io.Writer, id, name string, data []byte) error {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	data = bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+
+	var b bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "event: %s\n", name)
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&b, "data: %s\n", line)
+	}
+	b.WriteByte('\n')
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,97 @@
+package notes
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteEventFrame(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		event    string
+		data     string
+		expected string
+	}{
+		{
+			name:     "event",
+			id:       "7",
+			event:    "created",
+			data:     `{"id":"1"}`,
+			expected: "id: 7\nevent: created\ndata: {\"id\":\"1\"}\n\n",
+		},
+		{
+			name:     "error without id",
+			event:    "error",
+			data:     "stream closed",
+			expected: "event: error\ndata: stream closed\n\n",
+		},
+		{
+			name:     "line breaks stay in data",
+			event:    "error",
+			data:     "bad\n\nid: 99\revent: created\r\ndata: x",
+			expected: "event: error\ndata: bad\ndata: \ndata: id: 99\ndata: event: created\ndata: data: x\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := writeEventFrame(&b, tt.id, tt.event, []byte(tt.data)); err != nil {
+				t.Fatalf("writeEventFrame() error = %v", err)
+			}
+
+			if got := b.String(); got != tt.expected {
+				t.Errorf("writeEventFrame() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAuthorizeEventSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		header   string
+		cookie   string
+		expected string
+	}{
+		{
+			name:     "header wins",
+			target:   "/v1/events",
+			header:   "Bearer header",
+			cookie:   "cookie",
+			expected: "Bearer header",
+		},
+		{
+			name:     "cookie",
+			target:   "/v1/events",
+			cookie:   "cookie",
+			expected: "Bearer cookie",
+		},
+		{
+			name:   "anonymous",
+			target: "/v1/events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: tokenCookie, Value: tt.cookie})
+			}
+
+			authorizeEventSource(r)
+
+			if got := r.Header.Get("Authorization"); got != tt.expected {
+				t.Errorf("Authorization = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+// ifMatchKey is the metadata key the gateway forwards the If-Match header with.
+const ifMatchKey = runtime.MetadataPrefix + "if-match"
+
+var errRevisionRequired = errors.New("note revision is required")
+
+// GatewayOptions maps note revisions to ETag/If-Match HTTP headers,
+// stale writes to 412 Precondition Failed and too large attachments
+// to 413 Request Entity Too Large.
+func GatewayOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithForwardResponseOption(forwardETag),
+		runtime.WithErrorHandler(handleGatewayError),
+		runtime.WithIncomingHeaderMatcher(matchIncomingHeader),
+	}
+}
+
+// matchIncomingHeader forwards X-Share-Password in addition to the headers
+// forwarded by default.
+func matchIncomingHeader(key string) (string, bool) {
+	if strings.EqualFold(key, sharePasswordKey) {
+		return sharePasswordKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func forwardETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	r, ok := resp.(interface{ GetNote() *v1.Note })
+	if !ok || r.GetNote() == nil {
+		return nil
+	}
+
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(r.GetNote().GetRevision(), 10)))
+
+	return nil
+}
+
+func handleGatewayError(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	switch noteErrorReason(err) {
+	case v1.ErrorCode_ERROR_CODE_REVISION_MISMATCH:
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	case v1.ErrorCode_ERROR_CODE_ATTACHMENT_TOO_LARGE:
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusRequestEntityTooLarge, Err: err}
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+func noteErrorReason(err error) v1.ErrorCode {
+	st, ok := status.FromError(err)
+	if !ok {
+		return v1.ErrorCode_ERROR_CODE_NONE
+	}
+
+	for _, d := range st.Details() {
+		if noteErr, ok := d.(*v1.NoteError); ok {
+			return noteErr.GetReason()
+		}
+	}
+
+	return v1.ErrorCode_ERROR_CODE_NONE
+}
+
+// requestRevision returns the revision from the request body or,
+// when it is empty, from the If-Match header forwarded by the gateway.
+func requestRevision(ctx context.Context, revision int64) (int64, error) {
+	if revision != 0 {
+		return revision, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(ifMatchKey)
+	if len(values) == 0 {
+		return 0, errRevisionRequired
+	}
+
+	etag := strings.TrimPrefix(strings.TrimSpace(values[0]), "W/")
+
+	revision, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header %q", values[0])
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,122 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func (s *Service) UploadMetrics(stream v1.NoteAPI_UploadMetricsServer) error {
+	ctx := stream.Context()
+
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "upload metrics: %v", err)
+	}
+
+	summary, err := s.usecase.IngestMetrics(ctx, userID, func() (entity.MetricRecord, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				slogx.Info(ctx, "client close stream")
+			}
+			return entity.MetricRecord{}, err
+		}
+
+		// the batch id is validated by the interceptor
+		batchID, err := uuid.Parse(req.GetBatchId())
+		if err != nil {
+			return entity.MetricRecord{}, status.Errorf(codes.InvalidArgument, "invalid batch id: %v", err)
+		}
+
+		return entity.MetricRecord{
+			BatchID:  batchID,
+			Sequence: req.GetSequence(),
+			NoteView: entity.NoteView{
+				NoteID:   req.GetNoteId(),
+				ViewedAt: converter.ConvertDateTimeToTime(req.GetTimestamp()),
+				Count:    req.GetNoteViewCounter(),
+			},
+		}, nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "upload metrics: %v", err)
+	}
+
+	slogx.Info(ctx, "success to upload metrics",
+		slog.Int64("accepted", summary.Accepted),
+		slog.Int64("duplicated", summary.Duplicated),
+		slog.Int64("rejected", summary.Rejected),
+	)
+
+	return stream.SendAndClose(&v1.SummaryResponse{
+		TotalView:  summary.Views,
+		Accepted:   summary.Accepted,
+		Duplicated: summary.Duplicated,
+		Rejected:   summary.Rejected,
+	})
+}
+
+func (s *Service) GetNoteStats(ctx context.Context, req *v1.GetNoteStatsRequest) (*v1.GetNoteStatsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get note stats: %v", err)
+	}
+
+	stats, err := s.usecase.GetNoteStats(ctx, entity.NoteStatsQuery{
+		UserID:      userID,
+		NoteID:      req.GetNoteId(),
+		Granularity: converter.ConvertStatsGranularityFromProto(req.GetGranularity()),
+		From:        converter.ConvertDateTimeToTime(req.GetFrom()),
+		To:          converter.ConvertDateTimeToTime(req.GetTo()),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidStatsRange) {
+			return nil, status.Error(codes.InvalidArgument, "stats range is empty or too long")
+		}
+		return nil, noteError("get note stats", err)
+	}
+
+	return &v1.GetNoteStatsResponse{
+		Buckets:    conv.ConvertViewBucketsToProto(stats.Buckets),
+		TotalViews: stats.TotalViews,
+	}, nil
+}
+
+func (s *Service) GetTopNotes(ctx context.Context, req *v1.GetTopNotesRequest) (*v1.GetTopNotesResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get top notes: %v", err)
+	}
+
+	top, err := s.usecase.GetTopNotes(ctx, entity.TopNotesQuery{
+		UserID: userID,
+		From:   converter.ConvertDateTimeToTime(req.GetFrom()),
+		To:     converter.ConvertDateTimeToTime(req.GetTo()),
+		Limit:  int(req.GetLimit()),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidStatsRange) {
+			return nil, status.Error(codes.InvalidArgument, "stats range is empty")
+		}
+		return nil, status.Errorf(codes.Internal, "get top notes: %v", err)
+	}
+
+	return &v1.GetTopNotesResponse{
+		Notes: conv.ConvertNoteViewsListToProto(top),
+	}, nil
+}
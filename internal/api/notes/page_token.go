@@ -0,0 +1,223 @@
+package notes
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+var errPageTokenMismatch = errors.New("page token does not match request")
+
+// pageToken is an opaque keyset cursor handed out as next_page_token.
+// The ordering and a hash of the filters are kept to reject tokens replayed
+// with another order_by, time range or tags.
+type pageToken struct {
+	OrderBy    entity.NoteOrderBy `json:"order_by"`
+	Descending bool               `json:"descending"`
+	Filters    []byte             `json:"filters,omitempty"`
+	Cursor     entity.NoteCursor  `json:"cursor"`
+}
+
+// notesFilters are the NotesQuery filters a pageToken is bound to.
+type notesFilters struct {
+	CreatedFrom  time.Time `json:"created_from,omitzero"`
+	CreatedTo    time.Time `json:"created_to,omitzero"`
+	UpdatedFrom  time.Time `json:"updated_from,omitzero"`
+	UpdatedTo    time.Time `json:"updated_to,omitzero"`
+	Tags         []string  `json:"tags,omitempty"`
+	MatchAllTags bool      `json:"match_all_tags,omitempty"`
+}
+
+// filtersHash returns a hash of the query filters, the order of the tags
+// does not matter.
+func filtersHash(q entity.NotesQuery) ([]byte, error) {
+	tags := slices.Compact(slices.Sorted(slices.Values(q.Tags)))
+
+	raw, err := json.Marshal(notesFilters{
+		CreatedFrom:  q.CreatedFrom.UTC(),
+		CreatedTo:    q.CreatedTo.UTC(),
+		UpdatedFrom:  q.UpdatedFrom.UTC(),
+		UpdatedTo:    q.UpdatedTo.UTC(),
+		Tags:         tags,
+		MatchAllTags: q.MatchAllTags && len(tags) > 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal page token filters: %v", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return sum[:16], nil
+}
+
+// searchPageToken is a next_page_token of SearchNotes, bound to the query.
+type searchPageToken struct {
+	Query    string                `json:"query"`
+	Language entity.SearchLanguage `json:"language"`
+	Cursor   entity.SearchCursor   `json:"cursor"`
+}
+
+// revisionsPageToken is a next_page_token of ListNoteRevisions, bound to the note.
+type revisionsPageToken struct {
+	NoteID int64 `json:"note_id"`
+	Before int64 `json:"before"`
+}
+
+// chatPageToken is a next_page_token of ListChatMessages, bound to the note.
+type chatPageToken struct {
+	NoteID int64 `json:"note_id"`
+	Before int64 `json:"before"`
+}
+
+func encodePageToken(q entity.NotesQuery, cursor *entity.NoteCursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	filters, err := filtersHash(q)
+	if err != nil {
+		return "", err
+	}
+
+	return marshalToken(pageToken{
+		OrderBy:    q.OrderBy,
+		Descending: q.Descending,
+		Filters:    filters,
+		Cursor:     *cursor,
+	})
+}
+
+func decodePageToken(token string, q entity.NotesQuery) (*entity.NoteCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var pt pageToken
+	if err := unmarshalToken(token, &pt); err != nil {
+		return nil, err
+	}
+
+	filters, err := filtersHash(q)
+	if err != nil {
+		return nil, err
+	}
+
+	if pt.OrderBy != q.OrderBy || pt.Descending != q.Descending || !bytes.Equal(pt.Filters, filters) {
+		return nil, errPageTokenMismatch
+	}
+
+	return &pt.Cursor, nil
+}
+
+func encodeSearchPageToken(q entity.SearchQuery, cursor *entity.SearchCursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	return marshalToken(searchPageToken{
+		Query:    q.Query,
+		Language: q.Language,
+		Cursor:   *cursor,
+	})
+}
+
+func decodeSearchPageToken(token string, q entity.SearchQuery) (*entity.SearchCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var pt searchPageToken
+	if err := unmarshalToken(token, &pt); err != nil {
+		return nil, err
+	}
+
+	if pt.Query != q.Query || pt.Language != q.Language {
+		return nil, errPageTokenMismatch
+	}
+
+	return &pt.Cursor, nil
+}
+
+func encodeRevisionsPageToken(q entity.NoteRevisionsQuery, before int64) (string, error) {
+	if before == 0 {
+		return "", nil
+	}
+
+	return marshalToken(revisionsPageToken{
+		NoteID: q.NoteID,
+		Before: before,
+	})
+}
+
+func decodeRevisionsPageToken(token string, q entity.NoteRevisionsQuery) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var pt revisionsPageToken
+	if err := unmarshalToken(token, &pt); err != nil {
+		return 0, err
+	}
+
+	if pt.NoteID != q.NoteID {
+		return 0, errPageTokenMismatch
+	}
+
+	return pt.Before, nil
+}
+
+func encodeChatPageToken(q entity.ChatMessagesQuery, before int64) (string, error) {
+	if before == 0 {
+		return "", nil
+	}
+
+	return marshalToken(chatPageToken{
+		NoteID: q.NoteID,
+		Before: before,
+	})
+}
+
+func decodeChatPageToken(token string, q entity.ChatMessagesQuery) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var pt chatPageToken
+	if err := unmarshalToken(token, &pt); err != nil {
+		return 0, err
+	}
+
+	if pt.NoteID != q.NoteID {
+		return 0, errPageTokenMismatch
+	}
+
+	return pt.Before, nil
+}
+
+func marshalToken(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal page token: %v", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func unmarshalToken(token string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("decode page token: %v", err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("unmarshal page token: %v", err)
+	}
+
+	return nil
+}
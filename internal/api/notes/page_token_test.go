@@ -0,0 +1,85 @@
+package notes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+func TestPageTokenFilters(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := entity.NotesQuery{
+		OrderBy:     entity.NoteOrderByUpdatedAt,
+		Descending:  true,
+		CreatedFrom: from,
+		UpdatedTo:   from.AddDate(0, 1, 0),
+		Tags:        []string{"work", "go"},
+	}
+	cursor := &entity.NoteCursor{ID: 7, UpdatedAt: from.Add(time.Hour)}
+
+	token, err := encodePageToken(base, cursor)
+	if err != nil {
+		t.Fatalf("encodePageToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(q *entity.NotesQuery)
+		wantErr error
+	}{
+		{name: "same request", mutate: func(*entity.NotesQuery) {}},
+		{name: "tags in another order", mutate: func(q *entity.NotesQuery) { q.Tags = []string{"go", "work"} }},
+		{
+			name:   "same time in another zone",
+			mutate: func(q *entity.NotesQuery) { q.CreatedFrom = from.In(time.FixedZone("UTC+3", 3*60*60)) },
+		},
+		{name: "page size", mutate: func(q *entity.NotesQuery) { q.PageSize = 10 }},
+		{
+			name:    "order",
+			mutate:  func(q *entity.NotesQuery) { q.Descending = false },
+			wantErr: errPageTokenMismatch,
+		},
+		{
+			name:    "created from",
+			mutate:  func(q *entity.NotesQuery) { q.CreatedFrom = from.AddDate(0, 0, 1) },
+			wantErr: errPageTokenMismatch,
+		},
+		{
+			name:    "updated to dropped",
+			mutate:  func(q *entity.NotesQuery) { q.UpdatedTo = time.Time{} },
+			wantErr: errPageTokenMismatch,
+		},
+		{
+			name:    "updated from added",
+			mutate:  func(q *entity.NotesQuery) { q.UpdatedFrom = from },
+			wantErr: errPageTokenMismatch,
+		},
+		{
+			name:    "other tags",
+			mutate:  func(q *entity.NotesQuery) { q.Tags = []string{"work"} },
+			wantErr: errPageTokenMismatch,
+		},
+		{
+			name:    "match all tags",
+			mutate:  func(q *entity.NotesQuery) { q.MatchAllTags = true },
+			wantErr: errPageTokenMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := base
+			tt.mutate(&q)
+
+			got, err := decodePageToken(token, q)
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("decodePageToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (got.ID != cursor.ID || !got.UpdatedAt.Equal(cursor.UpdatedAt)) {
+				t.Errorf("decodePageToken() = %+v, want %+v", got, cursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package notes
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/grpcx"
+)
+
+// Policies collects auth_policy options of the methods of every v1 API
+// service, keyed by full method names.
+func Policies() map[string]grpcx.Policy {
+	policies := make(map[string]grpcx.Policy)
+
+	for _, file := range []protoreflect.FileDescriptor{
+		v1.File_api_notes_v1_notes_proto,
+		v1.File_api_notes_v1_notebooks_proto,
+		v1.File_api_notes_v1_webhooks_proto,
+	} {
+		services := file.Services()
+		for i := range services.Len() {
+			methods := services.Get(i).Methods()
+			for j := range methods.Len() {
+				m := methods.Get(j)
+
+				policy, ok := proto.GetExtension(m.Options(), v1.E_AuthPolicy).(*v1.AuthPolicy)
+				if !ok || policy == nil {
+					continue
+				}
+
+				fullMethod := "/" + string(m.Parent().FullName()) + "/" + string(m.Name())
+				policies[fullMethod] = grpcx.Policy{
+					Roles:  policy.GetRoles(),
+					Scopes: policy.GetScopes(),
+				}
+			}
+		}
+	}
+
+	return policies
+}
@@ -0,0 +1,32 @@
+package notes
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPoliciesAdminRoles(t *testing.T) {
+	policies := Policies()
+
+	for _, method := range []string{
+		"/api.notest.v1.NoteAPI/AdminPurgeNote",
+		"/api.notest.v1.WebhookAPI/AdminListWebhookDeliveries",
+		"/api.notest.v1.WebhookAPI/AdminRedeliverWebhook",
+	} {
+		if roles := policies[method].Roles; !slices.Equal(roles, []string{"admin"}) {
+			t.Errorf("%s roles = %v, want [admin]", method, roles)
+		}
+	}
+
+	// owners keep purging their notes and redelivering their webhooks
+	for _, method := range []string{
+		"/api.notest.v1.NoteAPI/PurgeNote",
+		"/api.notest.v1.NoteAPI/RestoreNote",
+		"/api.notest.v1.WebhookAPI/ListWebhookDeliveries",
+		"/api.notest.v1.WebhookAPI/RedeliverWebhook",
+	} {
+		if roles := policies[method].Roles; len(roles) != 0 {
+			t.Errorf("%s roles = %v, want none", method, roles)
+		}
+	}
+}
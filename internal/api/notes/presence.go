@@ -0,0 +1,97 @@
+package notes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func (s *Service) GetPresence(ctx context.Context, req *v1.GetPresenceRequest) (*v1.GetPresenceResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get presence: %v", err)
+	}
+
+	presence, err := s.usecase.GetPresence(ctx, userID, req.GetNoteId())
+	if err != nil {
+		return nil, noteError("get presence", err)
+	}
+
+	resp := &v1.GetPresenceResponse{Presence: make([]*v1.Presence, 0, len(presence))}
+	for _, p := range presence {
+		activities := make([]v1.PresenceActivity, 0, len(p.Activities))
+		for _, a := range p.Activities {
+			activities = append(activities, converter.ConvertPresenceActivityToProto(a))
+		}
+
+		resp.Presence = append(resp.Presence, &v1.Presence{
+			UserId:     p.UserID,
+			Activities: activities,
+			JoinedAt:   converter.ConvertTimeToDateTime(p.JoinedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// presence keeps the user present on the notes while a stream lasts,
+// the stream calls heartbeat every heartbeatInterval.
+type presence struct {
+	usecase   notesUsecase
+	userID    int64
+	noteIDs   []int64
+	activity  entity.PresenceActivity
+	sessionID uuid.UUID
+}
+
+func (s *Service) enterNotes(
+	ctx context.Context,
+	userID int64,
+	noteIDs []int64,
+	activity entity.PresenceActivity,
+) (*presence, error) {
+	sessionID, err := s.usecase.EnterNotes(ctx, userID, noteIDs, activity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &presence{
+		usecase:   s.usecase,
+		userID:    userID,
+		noteIDs:   noteIDs,
+		activity:  activity,
+		sessionID: sessionID,
+	}, nil
+}
+
+// heartbeat refreshes the session. A session expired while the stream
+// was stuck enters the notes again, so the user shows up once more.
+func (p *presence) heartbeat(ctx context.Context) {
+	err := p.usecase.RefreshPresence(ctx, p.sessionID)
+	if errors.Is(err, entity.ErrPresenceExpired) {
+		var sessionID uuid.UUID
+		if sessionID, err = p.usecase.EnterNotes(ctx, p.userID, p.noteIDs, p.activity); err == nil {
+			p.sessionID = sessionID
+		}
+	}
+
+	if err != nil && ctx.Err() == nil {
+		slogx.Warn(ctx, "refresh presence", slogx.Err(err), slogx.UserId(p.userID))
+	}
+}
+
+// leave ends the session once the stream is done.
+func (p *presence) leave(ctx context.Context) {
+	if err := p.usecase.LeaveNotes(context.WithoutCancel(ctx), p.sessionID); err != nil {
+		slogx.Error(ctx, "leave notes", slogx.Err(err), slogx.UserId(p.userID))
+	}
+}
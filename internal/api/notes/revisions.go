@@ -0,0 +1,113 @@
+package notes
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+func (s *Service) ListNoteRevisions(ctx context.Context, req *v1.ListNoteRevisionsRequest) (*v1.ListNoteRevisionsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list note revisions: %v", err)
+	}
+
+	q := entity.NoteRevisionsQuery{
+		UserID:   userID,
+		NoteID:   req.GetNoteId(),
+		PageSize: int(req.GetPageSize()),
+	}
+
+	q.Before, err = decodeRevisionsPageToken(req.GetPageToken(), q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "list note revisions: %v", err)
+	}
+
+	page, err := s.usecase.ListNoteRevisions(ctx, q)
+	if err != nil {
+		return nil, noteError("list note revisions", err)
+	}
+
+	nextPageToken, err := encodeRevisionsPageToken(q, page.Next)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list note revisions: %v", err)
+	}
+
+	return &v1.ListNoteRevisionsResponse{
+		Revisions:     conv.ConvertNoteRevisionsToProto(page.Revisions),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) GetNoteRevision(ctx context.Context, req *v1.GetNoteRevisionRequest) (*v1.GetNoteRevisionResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get note revision: %v", err)
+	}
+
+	rev, err := s.usecase.GetNoteRevision(ctx, userID, req.GetNoteId(), req.GetRevision())
+	if err != nil {
+		return nil, noteRevisionError("get note revision", err)
+	}
+
+	return &v1.GetNoteRevisionResponse{
+		Revision: conv.ConvertNoteRevisionToProto(rev),
+	}, nil
+}
+
+func (s *Service) DiffNoteRevisions(ctx context.Context, req *v1.DiffNoteRevisionsRequest) (*v1.DiffNoteRevisionsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "diff note revisions: %v", err)
+	}
+
+	d, err := s.usecase.DiffNoteRevisions(ctx, userID, req.GetNoteId(), req.GetFromRevision(), req.GetToRevision())
+	if err != nil {
+		return nil, noteRevisionError("diff note revisions", err)
+	}
+
+	return &v1.DiffNoteRevisionsResponse{Diff: d}, nil
+}
+
+func (s *Service) RevertNote(ctx context.Context, req *v1.RevertNoteRequest) (*v1.RevertNoteResponse, error) {
+	var (
+		upd entity.NoteUpdate
+		err error
+	)
+
+	upd.Revision, err = requestRevision(ctx, req.GetRevision())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "revert note: %v", err)
+	}
+
+	upd.EditorID, err = ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "revert note: %v", err)
+	}
+
+	note, err := s.usecase.RevertNote(ctx, req.GetNoteId(), req.GetToRevision(), upd)
+	if err != nil {
+		if errors.Is(err, entity.ErrNoteRevisionNotFound) {
+			return nil, status.Error(codes.NotFound, "note revision not found")
+		}
+		return nil, noteError("revert note", err)
+	}
+
+	return &v1.RevertNoteResponse{
+		Note: conv.ConvertNoteToProto(note),
+	}, nil
+}
+
+func noteRevisionError(op string, err error) error {
+	if errors.Is(err, entity.ErrNoteRevisionNotFound) {
+		return status.Error(codes.NotFound, "note revision not found")
+	}
+
+	return noteError(op, err)
+}
@@ -5,14 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log/slog"
-	"math/rand"
 	"time"
 
-	"golang.org/x/sync/errgroup"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
 	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter/generated"
@@ -27,12 +26,52 @@ var _ grpcx.Service = (*Service)(nil)
 
 var conv converter.Converter = &generated.ConverterImpl{}
 
+// heartbeatInterval is how often streams send health checks
+// and refresh the presence of the user.
+const heartbeatInterval = 5 * time.Second
+
 type notesUsecase interface {
-	CreateNote(ctx context.Context, userID int64, title, content string) (entity.Note, error)
-	GetNote(ctx context.Context, id int64) (entity.Note, error)
-	GetNotesByUserID(ctx context.Context, userID int64) ([]entity.Note, error)
-	DeleteNote(ctx context.Context, id int64) error
-	SubscribeToEvents(ctx context.Context, userID int64) (<-chan entity.CreateNoteEvent, error)
+	CreateNote(ctx context.Context, userID int64, title, content string, tags []string) (entity.Note, error)
+	GetNote(ctx context.Context, userID, id int64) (entity.Note, error)
+	ListNotes(ctx context.Context, q entity.NotesQuery) (entity.NotesPage, error)
+	SearchNotes(ctx context.Context, q entity.SearchQuery) (entity.SearchPage, error)
+	UpdateNote(ctx context.Context, id int64, upd entity.NoteUpdate) (entity.Note, error)
+	MoveNote(ctx context.Context, userID, id, notebookID, revision int64) (entity.Note, error)
+	DeleteNote(ctx context.Context, userID, id, revision int64) error
+	ListTrash(ctx context.Context, q entity.NotesQuery) (entity.NotesPage, error)
+	RestoreNote(ctx context.Context, userID, id int64) (entity.Note, error)
+	PurgeNote(ctx context.Context, userID, id int64) error
+	AdminPurgeNote(ctx context.Context, id int64) error
+	ShareNote(ctx context.Context, userID int64, c entity.Collaborator) (entity.Collaborator, error)
+	UnshareNote(ctx context.Context, userID, noteID, collaboratorID int64) error
+	ListCollaborators(ctx context.Context, userID, noteID int64) ([]entity.Collaborator, error)
+	CreateShareLink(ctx context.Context, userID, noteID int64, expiresAt time.Time, password string) (entity.ShareLink, error)
+	RevokeShareLink(ctx context.Context, userID, noteID, linkID int64) error
+	GetSharedNote(ctx context.Context, token, password string) (entity.SharedNote, error)
+	UploadAttachment(ctx context.Context, userID int64, a entity.Attachment, r io.Reader) (entity.Attachment, error)
+	OpenAttachment(ctx context.Context, userID, noteID, id int64) (entity.Attachment, io.ReadCloser, error)
+	ListAttachments(ctx context.Context, userID, noteID int64) ([]entity.Attachment, error)
+	ListTags(ctx context.Context, userID int64) ([]entity.Tag, error)
+	RenameTag(ctx context.Context, userID int64, name, newName string) (entity.Tag, error)
+	MergeTags(ctx context.Context, userID int64, sources []string, target string) error
+	ListNoteRevisions(ctx context.Context, q entity.NoteRevisionsQuery) (entity.NoteRevisionsPage, error)
+	GetNoteRevision(ctx context.Context, userID, noteID, revision int64) (entity.NoteRevision, error)
+	DiffNoteRevisions(ctx context.Context, userID, noteID, fromRevision, toRevision int64) (string, error)
+	RevertNote(ctx context.Context, id, toRevision int64, upd entity.NoteUpdate) (entity.Note, error)
+	SubscribeToEvents(ctx context.Context, userID, resumeAfter int64) (entity.NoteEventStream, error)
+	IngestMetrics(ctx context.Context, userID int64, next func() (entity.MetricRecord, error)) (entity.MetricsSummary, error)
+	GetNoteStats(ctx context.Context, q entity.NoteStatsQuery) (entity.NoteStats, error)
+	GetTopNotes(ctx context.Context, q entity.TopNotesQuery) ([]entity.NoteViews, error)
+	JoinChat(ctx context.Context, userID, noteID int64) (entity.ChatRoom, error)
+	PostChatMessage(ctx context.Context, m entity.ChatMessage) (entity.ChatMessage, error)
+	ListChatMessages(ctx context.Context, q entity.ChatMessagesQuery) (entity.ChatMessagesPage, error)
+	JoinEditSession(ctx context.Context, userID, noteID int64) (entity.EditSnapshot, entity.NoteEditStream, error)
+	ApplyEditOperation(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error)
+	MoveEditCursor(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error)
+	EnterNotes(ctx context.Context, userID int64, noteIDs []int64, activity entity.PresenceActivity) (uuid.UUID, error)
+	RefreshPresence(ctx context.Context, sessionID uuid.UUID) error
+	LeaveNotes(ctx context.Context, sessionID uuid.UUID) error
+	GetPresence(ctx context.Context, userID, noteID int64) ([]entity.Presence, error)
 }
 
 //go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.33.2 -out-filename=service_options.gen.go -from-struct=Options
@@ -63,7 +102,7 @@ func (s *Service) CreateNote(ctx context.Context, req *v1.CreateNoteRequest) (*v
 		return nil, status.Errorf(codes.Unauthenticated, "create note: %v", err)
 	}
 
-	note, err := s.usecase.CreateNote(ctx, userID, req.Title, req.Content)
+	note, err := s.usecase.CreateNote(ctx, userID, req.Title, req.Content, req.Tags)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "create note: %v", err)
 	}
@@ -77,16 +116,19 @@ func (s *Service) GetNote(ctx context.Context, req *v1.GetNoteRequest) (*v1.GetN
 	if req.GetNoteId() == 1 {
 		return nil, withNoteError(
 			codes.FailedPrecondition,
+			"get note error",
 			v1.ErrorCode_ERROR_CODE_INVALID_TEXT,
 		)
 	}
 
-	note, err := s.usecase.GetNote(ctx, req.NoteId)
+	userID, err := ctxtr.UserID(ctx)
 	if err != nil {
-		if errors.Is(err, entity.ErrNoteNotFound) {
-			return nil, status.Error(codes.NotFound, "note not found")
-		}
-		return nil, status.Errorf(codes.Internal, "get note: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "get note: %v", err)
+	}
+
+	note, err := s.usecase.GetNote(ctx, userID, req.NoteId)
+	if err != nil {
+		return nil, noteError("get note", err)
 	}
 
 	return &v1.GetNoteResponse{
@@ -94,8 +136,8 @@ func (s *Service) GetNote(ctx context.Context, req *v1.GetNoteRequest) (*v1.GetN
 	}, nil
 }
 
-func withNoteError(code codes.Code, reason v1.ErrorCode) error {
-	st := status.New(code, "get note error")
+func withNoteError(code codes.Code, msg string, reason v1.ErrorCode) error {
+	st := status.New(code, msg)
 
 	noteErr := &v1.NoteError{Reason: reason}
 
@@ -108,224 +150,373 @@ func withNoteError(code codes.Code, reason v1.ErrorCode) error {
 }
 
 func (s *Service) GetNotes(ctx context.Context, req *v1.GetNotesRequest) (*v1.GetNotesResponse, error) {
-	notes, err := s.usecase.GetNotesByUserID(ctx, req.UserId)
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "get notes: %v", err)
+	}
+
+	q := notesQueryFromRequest(req)
+	q.UserID = userID
+
+	after, err := decodePageToken(req.GetPageToken(), q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "get notes: %v", err)
+	}
+	q.After = after
+
+	page, err := s.usecase.ListNotes(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get notes: %v", err)
+	}
+
+	nextPageToken, err := encodePageToken(q, page.Next)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "get notes: %v", err)
 	}
 
 	return &v1.GetNotesResponse{
-		Notes: conv.ConvertNotesToProto(notes),
+		Notes:         conv.ConvertNotesToProto(page.Notes),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-func (s *Service) DeleteNote(ctx context.Context, req *v1.DeleteNoteRequest) (*v1.DeleteNoteResponse, error) {
-	if err := s.usecase.DeleteNote(ctx, req.NoteId); err != nil {
-		return nil, status.Errorf(codes.Internal, "delete note: %v", err)
+func notesQueryFromRequest(req *v1.GetNotesRequest) entity.NotesQuery {
+	q := entity.NotesQuery{
+		PageSize:    int(req.GetPageSize()),
+		CreatedFrom: converter.ConvertDateTimeToTime(req.GetCreatedFrom()),
+		CreatedTo:   converter.ConvertDateTimeToTime(req.GetCreatedTo()),
+		UpdatedFrom: converter.ConvertDateTimeToTime(req.GetUpdatedFrom()),
+		UpdatedTo:   converter.ConvertDateTimeToTime(req.GetUpdatedTo()),
+		Tags:        req.GetTags(),
 	}
 
-	return &v1.DeleteNoteResponse{}, nil
+	q.MatchAllTags = req.GetTagMatch() == v1.TagMatch_TAG_MATCH_ALL
+
+	switch req.GetOrderBy() {
+	case v1.NoteOrderBy_NOTE_ORDER_BY_UPDATED_AT:
+		q.OrderBy = entity.NoteOrderByUpdatedAt
+	case v1.NoteOrderBy_NOTE_ORDER_BY_TITLE:
+		q.OrderBy = entity.NoteOrderByTitle
+	default:
+		q.OrderBy = entity.NoteOrderByCreatedAt
+	}
+
+	switch req.GetDirection() {
+	case v1.SortDirection_SORT_DIRECTION_ASC:
+		q.Descending = false
+	case v1.SortDirection_SORT_DIRECTION_DESC:
+		q.Descending = true
+	default:
+		q.Descending = q.OrderBy != entity.NoteOrderByTitle
+	}
+
+	return q
 }
 
-func (s *Service) SubscribeToEvents(req *v1.SubscribeToEventRequest, stream v1.NoteAPI_SubscribeToEventsServer) error {
-	ctx := stream.Context()
+func (s *Service) SearchNotes(ctx context.Context, req *v1.SearchNotesRequest) (*v1.SearchNotesResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "search notes: %v", err)
+	}
 
-	slogx.Info(ctx, "client subscribe to events", slogx.UserId(req.UserId))
+	q := entity.SearchQuery{
+		UserID:   userID,
+		Query:    req.GetQuery(),
+		Language: searchLanguageFromProto(req.GetLanguage()),
+		PageSize: int(req.GetPageSize()),
+	}
 
-	if err := sendHealthCheck(ctx, stream); err != nil {
-		return fmt.Errorf("send first health check: %v", err)
+	q.After, err = decodeSearchPageToken(req.GetPageToken(), q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "search notes: %v", err)
 	}
 
-	events, err := s.usecase.SubscribeToEvents(ctx, req.GetUserId())
+	page, err := s.usecase.SearchNotes(ctx, q)
 	if err != nil {
-		return fmt.Errorf("get events: %v", err)
+		return nil, status.Errorf(codes.Internal, "search notes: %v", err)
 	}
 
-	ticker := time.NewTicker(time.Second * 5)
-	defer ticker.Stop()
+	nextPageToken, err := encodeSearchPageToken(q, page.Next)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "search notes: %v", err)
+	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			slogx.Debug(ctx, "context canceled")
-			return nil
+	results := make([]*v1.SearchResult, 0, len(page.Results))
+	for _, r := range page.Results {
+		results = append(results, &v1.SearchResult{
+			Note:       conv.ConvertNoteToProto(r.Note),
+			Rank:       r.Rank,
+			Snippet:    r.Snippet,
+			Highlights: textRangesToProto(r.Highlights),
+		})
+	}
 
-		case <-ticker.C:
-			if err := sendHealthCheck(ctx, stream); err != nil {
-				return err
-			}
-		case event := <-events:
+	return &v1.SearchNotesResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
 
-			n := conv.ConvertNoteToProto(event.CreatedNote)
-			note := v1.SubscribeToEventResponse_CreatedNote{CreatedNote: n}
-			resp := v1.SubscribeToEventResponse{Result: &note}
+func textRangesToProto(ranges []entity.TextRange) []*v1.TextRange {
+	result := make([]*v1.TextRange, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, &v1.TextRange{Start: int32(r.Start), End: int32(r.End)})
+	}
 
-			if err := stream.Send(&resp); err != nil {
-				code := status.Code(err)
+	return result
+}
 
-				switch code {
-				case codes.Canceled | codes.DeadlineExceeded:
-					slogx.Info(ctx, "client unsubscribe", slogx.GrpcCode(code))
-					return nil
-				case codes.Unavailable:
-					slogx.Warn(ctx, "client unavailable")
-					return nil
-				default:
-					slogx.Error(ctx, "unexpected send error", slogx.Err(err), slogx.GrpcCode(code))
-					return err
-				}
-			}
-		}
+func searchLanguageFromProto(lang v1.SearchLanguage) entity.SearchLanguage {
+	switch lang {
+	case v1.SearchLanguage_SEARCH_LANGUAGE_ENGLISH:
+		return entity.SearchLanguageEnglish
+	case v1.SearchLanguage_SEARCH_LANGUAGE_RUSSIAN:
+		return entity.SearchLanguageRussian
+	default:
+		return entity.SearchLanguageSimple
 	}
 }
 
-func (s *Service) UploadMetrics(stream v1.NoteAPI_UploadMetricsServer) error {
-	ctx := stream.Context()
+func (s *Service) UpdateNote(ctx context.Context, req *v1.UpdateNoteRequest) (*v1.UpdateNoteResponse, error) {
+	upd, err := noteUpdateFromRequest(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update note: %v", err)
+	}
 
-	var sum v1.SummaryResponse
-	for {
-		if ctx.Err() != nil {
-			slogx.Info(ctx, "context canceled")
-			break
+	upd.Revision, err = requestRevision(ctx, req.GetRevision())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update note: %v", err)
+	}
+
+	upd.EditorID, err = ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "update note: %v", err)
+	}
+
+	note, err := s.usecase.UpdateNote(ctx, req.NoteId, upd)
+	if err != nil {
+		return nil, noteError("update note", err)
+	}
+
+	return &v1.UpdateNoteResponse{
+		Note: conv.ConvertNoteToProto(note),
+	}, nil
+}
+
+// noteUpdateFromRequest applies the request field mask. An empty mask
+// means "update every field present in the request", fields named in
+// the mask must be present.
+func noteUpdateFromRequest(req *v1.UpdateNoteRequest) (entity.NoteUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		upd := entity.NoteUpdate{Title: req.Title, Content: req.Content}
+		if len(req.GetTags()) > 0 {
+			upd.Tags = req.GetTags()
 		}
+		return upd, nil
+	}
 
-		resp, err := stream.Recv()
-		if err != nil {
-			if err == io.EOF {
-				slogx.Info(ctx, "client close stream")
-				break
+	var upd entity.NoteUpdate
+	for _, path := range paths {
+		switch path {
+		case "title":
+			if req.Title == nil {
+				return entity.NoteUpdate{}, errors.New("title is in update mask but not set")
 			}
-
-			return err
+			upd.Title = proto.String(req.GetTitle())
+		case "content":
+			if req.Content == nil {
+				return entity.NoteUpdate{}, errors.New("content is in update mask but not set")
+			}
+			upd.Content = proto.String(req.GetContent())
+		case "tags":
+			// not nil even when empty, so that the tags are cleared
+			upd.Tags = append([]string{}, req.GetTags()...)
+		default:
+			return entity.NoteUpdate{}, fmt.Errorf("unsupported update mask path %q", path)
 		}
+	}
 
-		sum.TotalView += resp.NoteViewCounter
-		slogx.Info(ctx, "receive metrics from client")
+	return upd, nil
+}
+
+func (s *Service) DeleteNote(ctx context.Context, req *v1.DeleteNoteRequest) (*v1.DeleteNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "delete note: %v", err)
 	}
 
-	if err := stream.SendAndClose(&sum); err != nil {
-		return err
+	revision, err := requestRevision(ctx, req.GetRevision())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "delete note: %v", err)
 	}
 
-	return nil
+	if err := s.usecase.DeleteNote(ctx, userID, req.NoteId, revision); err != nil {
+		return nil, noteError("delete note", err)
+	}
+
+	return &v1.DeleteNoteResponse{}, nil
 }
 
-func (s *Service) Chat(stream v1.NoteAPI_ChatServer) error {
-	ctx := stream.Context()
+func (s *Service) MoveNote(ctx context.Context, req *v1.MoveNoteRequest) (*v1.MoveNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "move note: %v", err)
+	}
 
-	messages := getMessages()
-	eg, ctx := errgroup.WithContext(ctx)
+	revision, err := requestRevision(ctx, req.GetRevision())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "move note: %v", err)
+	}
 
-	ackChan := make(chan string)
+	note, err := s.usecase.MoveNote(ctx, userID, req.GetNoteId(), req.GetNotebookId(), revision)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotebookNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "notebook not found")
+		}
+		return nil, noteError("move note", err)
+	}
 
-	eg.Go(func() error {
-		defer close(ackChan)
+	return &v1.MoveNoteResponse{
+		Note: conv.ConvertNoteToProto(note),
+	}, nil
+}
 
-		for {
-			if ctx.Err() != nil {
-				return ctx.Err()
-			}
+func noteError(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNoteNotFound):
+		return status.Error(codes.NotFound, "note not found")
+	case errors.Is(err, entity.ErrNoteAccessDenied):
+		return withNoteError(
+			codes.PermissionDenied,
+			"not enough permissions for the note",
+			v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+		)
+	case errors.Is(err, entity.ErrNoteRevisionMismatch):
+		return withNoteError(
+			codes.Aborted,
+			"note was modified concurrently",
+			v1.ErrorCode_ERROR_CODE_REVISION_MISMATCH,
+		)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", op, err)
+	}
+}
 
-			msg, err := stream.Recv()
-			if err != nil {
-				if err == io.EOF {
-					slogx.Info(ctx, "client closed stream")
-					return nil
-				}
+func (s *Service) SubscribeToEvents(req *v1.SubscribeToEventRequest, stream v1.NoteAPI_SubscribeToEventsServer) error {
+	ctx := stream.Context()
 
-				return fmt.Errorf("receive msg: %v", err)
-			}
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "subscribe to events: %v", err)
+	}
 
-			correlationID := msg.CorrelationId
+	slogx.Info(ctx, "client subscribe to events", slogx.UserId(userID))
 
-			eg.Go(func() error {
-				select {
-				case <-ctx.Done():
-					return nil
-				case ackChan <- correlationID:
-				}
+	if err := sendHealthCheck(ctx, stream); err != nil {
+		return fmt.Errorf("send first health check: %v", err)
+	}
 
-				return nil
-			})
+	events, err := s.usecase.SubscribeToEvents(ctx, userID, req.GetResumeAfterSequence())
+	if err != nil {
+		return fmt.Errorf("get events: %v", err)
+	}
 
-			slogx.Info(ctx, "receive msg from client", slog.String("msg", msg.GetContent()))
+	// Subscribed first, so the user sees their own presence joined events.
+	var p *presence
+	if len(req.GetOpenNoteIds()) > 0 {
+		if p, err = s.enterNotes(ctx, userID, req.GetOpenNoteIds(), entity.PresenceViewing); err != nil {
+			return noteError("subscribe to events", err)
 		}
-	})
-
-	eg.Go(func() error {
-		ticker := time.NewTicker(500 * time.Millisecond)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				slogx.Info(ctx, "context canceled in send gorutine")
-				return nil
-			case <-ticker.C:
-			}
+		defer p.leave(ctx)
+	}
 
-			idx := rand.Intn(10)
-			msg := messages[idx]
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
 
-			if err := stream.Send(&v1.ServerMessage{
-				Content: msg,
-			}); err != nil {
-				return fmt.Errorf("send msg: %v", err)
-			}
-		}
-	})
-
-	eg.Go(func() error {
-		for {
-			select {
-			case <-ctx.Done():
-				slogx.Info(ctx, "context canceled in ack gorutine")
-				return nil
-			case correlationID, ok := <-ackChan:
-				if !ok {
-					return nil
-				}
+	for {
+		select {
+		case <-ctx.Done():
+			slogx.Debug(ctx, "context canceled")
+			return nil
 
-				msg := v1.ServerMessage{IsAck: true, CorrelationId: correlationID}
+		case <-ticker.C:
+			if err := sendHealthCheck(ctx, stream); err != nil {
+				return err
+			}
+			if p != nil {
+				p.heartbeat(ctx)
+			}
+		case event, ok := <-events.Events():
+			if !ok {
+				return eventStreamError(ctx, events.Err())
+			}
 
-				if err := stream.Send(&msg); err != nil {
-					slogx.Error(ctx, "while ack client message", slog.String("correlation_id", correlationID))
+			if err := stream.Send(noteEventToProto(event)); err != nil {
+				code := status.Code(err)
 
-					continue
+				switch code {
+				case codes.Canceled | codes.DeadlineExceeded:
+					slogx.Info(ctx, "client unsubscribe", slogx.GrpcCode(code))
+					return nil
+				case codes.Unavailable:
+					slogx.Warn(ctx, "client unavailable")
+					return nil
+				default:
+					slogx.Error(ctx, "unexpected send error", slogx.Err(err), slogx.GrpcCode(code))
+					return err
 				}
-
-				slogx.Info(ctx, "success to ack message", slog.String("correlation_id", correlationID))
 			}
 		}
-	})
-
-	if err := eg.Wait(); err != nil && err != context.Canceled {
-		return fmt.Errorf("stream waiting: %v", err)
 	}
+}
 
-	return nil
+// eventStreamError asks clients to resubscribe after the last received
+// sequence when the event stream ends before the client leaves.
+func eventStreamError(ctx context.Context, err error) error {
+	switch {
+	case ctx.Err() != nil:
+		return nil
+	case errors.Is(err, entity.ErrSlowSubscriber):
+		return status.Error(codes.ResourceExhausted, "subscriber is too slow, resume after the last sequence")
+	default:
+		return status.Error(codes.Unavailable, "event stream closed, resume after the last sequence")
+	}
 }
 
-func getMessages() map[int]string {
-	phrases := []string{
-		"hello",
-		"how are you?",
-		"how does going?",
-		"okey",
-		"stay in touch",
-		"nice to meet you",
-		"good morning",
-		"afternoon!",
-		"hi, fellas!",
-		"hello, people!",
+func noteEventToProto(event entity.NoteEvent) *v1.SubscribeToEventResponse {
+	note := conv.ConvertNoteToProto(event.Note)
+
+	resp := v1.SubscribeToEventResponse{Sequence: event.Sequence}
+	switch event.Type {
+	case entity.NoteEventCreated:
+		resp.Result = &v1.SubscribeToEventResponse_CreatedNote{CreatedNote: note}
+	case entity.NoteEventUpdated:
+		resp.Result = &v1.SubscribeToEventResponse_UpdatedNote{UpdatedNote: note}
+	case entity.NoteEventDeleted:
+		resp.Result = &v1.SubscribeToEventResponse_DeletedNote{DeletedNote: note}
+	case entity.NoteEventRestored:
+		resp.Result = &v1.SubscribeToEventResponse_RestoredNote{RestoredNote: note}
+	case entity.NoteEventShared:
+		resp.Result = &v1.SubscribeToEventResponse_SharedNote{SharedNote: &v1.NoteShared{
+			Note:         note,
+			Collaborator: conv.ConvertCollaboratorToProto(event.Collaborator),
+		}}
+	case entity.NoteEventPresenceJoined:
+		resp.Result = &v1.SubscribeToEventResponse_PresenceJoined{PresenceJoined: presenceChangeToProto(event)}
+	case entity.NoteEventPresenceLeft:
+		resp.Result = &v1.SubscribeToEventResponse_PresenceLeft{PresenceLeft: presenceChangeToProto(event)}
 	}
 
-	messages := make(map[int]string, 10)
+	return &resp
+}
 
-	for idx, phrase := range phrases {
-		messages[idx] = phrase
+func presenceChangeToProto(event entity.NoteEvent) *v1.PresenceChange {
+	return &v1.PresenceChange{
+		NoteId:   event.Note.ID,
+		UserId:   event.Presence.UserID,
+		Activity: converter.ConvertPresenceActivityToProto(event.Presence.Activity),
 	}
-
-	return messages
 }
 
 func sendHealthCheck(_ context.Context, stream v1.NoteAPI_SubscribeToEventsServer) error {
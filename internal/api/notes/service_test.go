@@ -0,0 +1,52 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+func TestEventStreamError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		expected codes.Code
+	}{
+		{
+			name:     "slow subscriber",
+			ctx:      context.Background(),
+			err:      entity.ErrSlowSubscriber,
+			expected: codes.ResourceExhausted,
+		},
+		{
+			name:     "stream closed",
+			ctx:      context.Background(),
+			err:      errors.New("listener closed"),
+			expected: codes.Unavailable,
+		},
+		{
+			name:     "client gone",
+			ctx:      canceled,
+			err:      entity.ErrSlowSubscriber,
+			expected: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := eventStreamError(tt.ctx, tt.err)
+			if code := status.Code(err); code != tt.expected {
+				t.Errorf("eventStreamError() code = %v, want %v", code, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+// sharePasswordKey is the metadata key the gateway forwards
+// the X-Share-Password header with.
+const sharePasswordKey = "x-share-password"
+
+const sharedNotePathPrefix = "/v1/shared/"
+
+// PublicMethods lists methods callable without an authorization token.
+func PublicMethods() []string {
+	return []string{
+		"/" + v1.NoteAPI_ServiceDesc.ServiceName + "/GetSharedNote",
+	}
+}
+
+func (s *Service) CreateShareLink(ctx context.Context, req *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "create share link: %v", err)
+	}
+
+	expiresAt := converter.ConvertDateTimeToTime(req.GetExpiresAt())
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "share link expiry must be in the future")
+	}
+
+	link, err := s.usecase.CreateShareLink(ctx, userID, req.GetNoteId(), expiresAt, req.GetPassword())
+	if err != nil {
+		return nil, noteError("create share link", err)
+	}
+
+	return &v1.CreateShareLinkResponse{
+		Link: &v1.ShareLink{
+			Id:          link.ID,
+			NoteId:      link.NoteID,
+			Token:       link.Token,
+			Path:        sharedNotePathPrefix + link.Token,
+			ExpiresAt:   converter.ConvertTimeToDateTime(link.ExpiresAt),
+			HasPassword: link.HasPassword(),
+			CreatedAt:   converter.ConvertTimeToDateTime(link.CreatedAt),
+		},
+	}, nil
+}
+
+func (s *Service) RevokeShareLink(ctx context.Context, req *v1.RevokeShareLinkRequest) (*v1.RevokeShareLinkResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "revoke share link: %v", err)
+	}
+
+	if err := s.usecase.RevokeShareLink(ctx, userID, req.GetNoteId(), req.GetLinkId()); err != nil {
+		if errors.Is(err, entity.ErrShareLinkNotFound) {
+			return nil, status.Error(codes.NotFound, "share link not found")
+		}
+		return nil, noteError("revoke share link", err)
+	}
+
+	return &v1.RevokeShareLinkResponse{}, nil
+}
+
+func (s *Service) GetSharedNote(ctx context.Context, req *v1.GetSharedNoteRequest) (*v1.GetSharedNoteResponse, error) {
+	shared, err := s.usecase.GetSharedNote(ctx, req.GetToken(), sharePassword(ctx, req.GetPassword()))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrShareLinkNotFound):
+			return nil, status.Error(codes.NotFound, "share link not found or expired")
+		case errors.Is(err, entity.ErrShareLinkPasswordInvalid):
+			return nil, withNoteError(
+				codes.PermissionDenied,
+				"invalid share link password",
+				v1.ErrorCode_ERROR_CODE_PERMISSION_DENIED,
+			)
+		default:
+			return nil, status.Errorf(codes.Internal, "get shared note: %v", err)
+		}
+	}
+
+	return &v1.GetSharedNoteResponse{
+		SharedNote: &v1.SharedNote{
+			Title:     shared.Note.Title,
+			Content:   shared.Note.Content,
+			Html:      renderHTML(shared.Note.Content),
+			Tags:      shared.Note.Tags,
+			UpdatedAt: converter.ConvertTimeToDateTime(shared.Note.UpdatedAt),
+			ExpiresAt: converter.ConvertTimeToDateTime(shared.ExpiresAt),
+		},
+	}, nil
+}
+
+// sharePassword returns the password from the request or, when it is empty,
+// from the X-Share-Password header forwarded by the gateway.
+func sharePassword(ctx context.Context, password string) string {
+	if password != "" {
+		return password
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(sharePasswordKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// renderHTML renders plain text content as HTML paragraphs separated by
+// blank lines, any markup in the content is escaped.
+func renderHTML(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+
+		lines := strings.Split(paragraph, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(lines, "<br>"))
+		b.WriteString("</p>\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package notes
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+func (s *Service) ListTags(ctx context.Context, _ *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list tags: %v", err)
+	}
+
+	tags, err := s.usecase.ListTags(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list tags: %v", err)
+	}
+
+	return &v1.ListTagsResponse{
+		Tags: conv.ConvertTagsToProto(tags),
+	}, nil
+}
+
+func (s *Service) RenameTag(ctx context.Context, req *v1.RenameTagRequest) (*v1.RenameTagResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "rename tag: %v", err)
+	}
+
+	tag, err := s.usecase.RenameTag(ctx, userID, req.GetName(), req.GetNewName())
+	if err != nil {
+		return nil, tagError("rename tag", err)
+	}
+
+	return &v1.RenameTagResponse{
+		Tag: conv.ConvertTagToProto(tag),
+	}, nil
+}
+
+func (s *Service) MergeTags(ctx context.Context, req *v1.MergeTagsRequest) (*v1.MergeTagsResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "merge tags: %v", err)
+	}
+
+	if err := s.usecase.MergeTags(ctx, userID, req.GetSources(), req.GetTarget()); err != nil {
+		return nil, tagError("merge tags", err)
+	}
+
+	return &v1.MergeTagsResponse{}, nil
+}
+
+func tagError(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrTagNotFound):
+		return status.Error(codes.NotFound, "tag not found")
+	case errors.Is(err, entity.ErrTagAlreadyExists):
+		return status.Error(codes.AlreadyExists, "tag already exists, merge the tags instead")
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", op, err)
+	}
+}
@@ -0,0 +1,93 @@
+package notes
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+func (s *Service) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list trash: %v", err)
+	}
+
+	q := entity.NotesQuery{
+		UserID:     userID,
+		PageSize:   int(req.GetPageSize()),
+		OrderBy:    entity.NoteOrderByDeletedAt,
+		Descending: true,
+	}
+
+	q.After, err = decodePageToken(req.GetPageToken(), q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "list trash: %v", err)
+	}
+
+	page, err := s.usecase.ListTrash(ctx, q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list trash: %v", err)
+	}
+
+	nextPageToken, err := encodePageToken(q, page.Next)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list trash: %v", err)
+	}
+
+	return &v1.ListTrashResponse{
+		Notes:         conv.ConvertNotesToProto(page.Notes),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) RestoreNote(ctx context.Context, req *v1.RestoreNoteRequest) (*v1.RestoreNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "restore note: %v", err)
+	}
+
+	note, err := s.usecase.RestoreNote(ctx, userID, req.GetNoteId())
+	if err != nil {
+		if errors.Is(err, entity.ErrNoteNotFound) {
+			return nil, status.Error(codes.NotFound, "note not found in trash")
+		}
+		return nil, noteError("restore note", err)
+	}
+
+	return &v1.RestoreNoteResponse{
+		Note: conv.ConvertNoteToProto(note),
+	}, nil
+}
+
+func (s *Service) PurgeNote(ctx context.Context, req *v1.PurgeNoteRequest) (*v1.PurgeNoteResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "purge note: %v", err)
+	}
+
+	if err := s.usecase.PurgeNote(ctx, userID, req.GetNoteId()); err != nil {
+		if errors.Is(err, entity.ErrNoteNotFound) {
+			return nil, status.Error(codes.NotFound, "note not found in trash")
+		}
+		return nil, noteError("purge note", err)
+	}
+
+	return &v1.PurgeNoteResponse{}, nil
+}
+
+func (s *Service) AdminPurgeNote(ctx context.Context, req *v1.AdminPurgeNoteRequest) (*v1.AdminPurgeNoteResponse, error) {
+	if err := s.usecase.AdminPurgeNote(ctx, req.GetNoteId()); err != nil {
+		if errors.Is(err, entity.ErrNoteNotFound) {
+			return nil, status.Error(codes.NotFound, "note not found in trash")
+		}
+		return nil, noteError("admin purge note", err)
+	}
+
+	return &v1.AdminPurgeNoteResponse{}, nil
+}
@@ -0,0 +1,129 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+)
+
+const (
+	attachmentUploadPath = "/v1/notes/{note_id}/attachments"
+	attachmentFilePart   = "file"
+)
+
+// RegisterAttachmentUploadHandler serves multipart/form-data uploads on
+// POST /v1/notes/{note_id}/attachments, the "file" part is streamed to
+// UploadAttachment without buffering it in memory.
+func RegisterAttachmentUploadHandler(mux *runtime.ServeMux, client v1.NoteAPIClient) error {
+	return mux.HandlePath(http.MethodPost, attachmentUploadPath,
+		func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+
+			ctx, err := runtime.AnnotateContext(r.Context(), mux, r,
+				"/"+v1.NoteAPI_ServiceDesc.ServiceName+"/UploadAttachment",
+				runtime.WithHTTPPathPattern(attachmentUploadPath),
+			)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			resp, err := uploadAttachment(ctx, client, r, params["note_id"])
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+
+			runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+		})
+}
+
+func uploadAttachment(
+	ctx context.Context,
+	client v1.NoteAPIClient,
+	r *http.Request,
+	rawNoteID string,
+) (*v1.UploadAttachmentResponse, error) {
+	noteID, err := strconv.ParseInt(rawNoteID, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid note_id %q", rawNoteID)
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "read multipart body: %v", err)
+	}
+
+	var part io.Reader
+	var meta v1.AttachmentMetadata
+	for part == nil {
+		p, err := reader.NextPart()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, status.Errorf(codes.InvalidArgument, "multipart %q part is required", attachmentFilePart)
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "read multipart part: %v", err)
+		}
+
+		if p.FormName() == attachmentFilePart {
+			part = p
+			meta = v1.AttachmentMetadata{
+				NoteId:      noteID,
+				Filename:    p.FileName(),
+				ContentType: p.Header.Get("Content-Type"),
+			}
+		}
+	}
+
+	stream, err := client.UploadAttachment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&v1.UploadAttachmentRequest{
+		Data: &v1.UploadAttachmentRequest_Metadata{Metadata: &meta},
+	}); err != nil {
+		return nil, closeUpload(stream, err)
+	}
+
+	for {
+		chunk := make([]byte, attachmentChunkSize)
+
+		n, err := io.ReadFull(part, chunk)
+		if n > 0 {
+			if err := stream.Send(&v1.UploadAttachmentRequest{
+				Data: &v1.UploadAttachmentRequest_Chunk{Chunk: chunk[:n]},
+			}); err != nil {
+				return nil, closeUpload(stream, err)
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "read multipart file: %v", err)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// closeUpload returns the server error when the server has already
+// closed the stream, Send reports only io.EOF in this case.
+func closeUpload(stream v1.NoteAPI_UploadAttachmentClient, err error) error {
+	if !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
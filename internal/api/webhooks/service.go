@@ -0,0 +1,185 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/api/notes/converter/generated"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/ctxtr"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	v1 "github.com/evgeniy-krivenko/grpc-notes/pkg/api/notes/v1"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/grpcx"
+)
+
+var _ grpcx.Service = (*Service)(nil)
+
+var conv converter.Converter = &generated.ConverterImpl{}
+
+type webhooksUsecase interface {
+	CreateWebhook(ctx context.Context, w entity.Webhook) (entity.Webhook, error)
+	ListWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, id int64) error
+	ListWebhookDeliveries(ctx context.Context, userID, webhookID int64, pageSize int) ([]entity.WebhookDelivery, error)
+	RedeliverWebhook(ctx context.Context, userID, webhookID, deliveryID int64) (entity.WebhookDelivery, error)
+	AdminListWebhookDeliveries(ctx context.Context, webhookID int64, pageSize int) ([]entity.WebhookDelivery, error)
+	AdminRedeliverWebhook(ctx context.Context, webhookID, deliveryID int64) (entity.WebhookDelivery, error)
+}
+
+//go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.33.2 -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	usecase webhooksUsecase `option:"mandatory" validate:"required"`
+}
+
+type Service struct {
+	v1.UnimplementedWebhookAPIServer
+	Options
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate webhooks service options: %v", err)
+	}
+
+	return &Service{Options: opts}, nil
+}
+
+func (s *Service) RegisterService(srv grpc.ServiceRegistrar) {
+	v1.RegisterWebhookAPIServer(srv, s)
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, req *v1.CreateWebhookRequest) (*v1.CreateWebhookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "create webhook: %v", err)
+	}
+
+	eventTypes := make([]entity.NoteEventType, 0, len(req.GetEventTypes()))
+	for _, t := range req.GetEventTypes() {
+		eventTypes = append(eventTypes, converter.ConvertNoteEventTypeFromProto(t))
+	}
+
+	w, err := s.usecase.CreateWebhook(ctx, entity.Webhook{
+		UserID:     userID,
+		URL:        req.GetUrl(),
+		Secret:     req.GetSecret(),
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, webhookError("create webhook", err)
+	}
+
+	return &v1.CreateWebhookResponse{
+		Webhook: conv.ConvertWebhookToProto(w),
+	}, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context, _ *v1.ListWebhooksRequest) (*v1.ListWebhooksResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list webhooks: %v", err)
+	}
+
+	webhooks, err := s.usecase.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list webhooks: %v", err)
+	}
+
+	return &v1.ListWebhooksResponse{
+		Webhooks: conv.ConvertWebhooksToProto(webhooks),
+	}, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, req *v1.DeleteWebhookRequest) (*v1.DeleteWebhookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "delete webhook: %v", err)
+	}
+
+	if err := s.usecase.DeleteWebhook(ctx, userID, req.GetWebhookId()); err != nil {
+		return nil, webhookError("delete webhook", err)
+	}
+
+	return &v1.DeleteWebhookResponse{}, nil
+}
+
+func (s *Service) ListWebhookDeliveries(
+	ctx context.Context,
+	req *v1.ListWebhookDeliveriesRequest,
+) (*v1.ListWebhookDeliveriesResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "list webhook deliveries: %v", err)
+	}
+
+	deliveries, err := s.usecase.ListWebhookDeliveries(ctx, userID, req.GetWebhookId(), int(req.GetPageSize()))
+	if err != nil {
+		return nil, webhookError("list webhook deliveries", err)
+	}
+
+	return &v1.ListWebhookDeliveriesResponse{
+		Deliveries: conv.ConvertWebhookDeliveriesToProto(deliveries),
+	}, nil
+}
+
+func (s *Service) RedeliverWebhook(ctx context.Context, req *v1.RedeliverWebhookRequest) (*v1.RedeliverWebhookResponse, error) {
+	userID, err := ctxtr.UserID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "redeliver webhook: %v", err)
+	}
+
+	d, err := s.usecase.RedeliverWebhook(ctx, userID, req.GetWebhookId(), req.GetDeliveryId())
+	if err != nil {
+		return nil, webhookError("redeliver webhook", err)
+	}
+
+	return &v1.RedeliverWebhookResponse{
+		Delivery: conv.ConvertWebhookDeliveryToProto(d),
+	}, nil
+}
+
+func (s *Service) AdminListWebhookDeliveries(
+	ctx context.Context,
+	req *v1.AdminListWebhookDeliveriesRequest,
+) (*v1.AdminListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.usecase.AdminListWebhookDeliveries(ctx, req.GetWebhookId(), int(req.GetPageSize()))
+	if err != nil {
+		return nil, webhookError("admin list webhook deliveries", err)
+	}
+
+	return &v1.AdminListWebhookDeliveriesResponse{
+		Deliveries: conv.ConvertWebhookDeliveriesToProto(deliveries),
+	}, nil
+}
+
+func (s *Service) AdminRedeliverWebhook(
+	ctx context.Context,
+	req *v1.AdminRedeliverWebhookRequest,
+) (*v1.AdminRedeliverWebhookResponse, error) {
+	d, err := s.usecase.AdminRedeliverWebhook(ctx, req.GetWebhookId(), req.GetDeliveryId())
+	if err != nil {
+		return nil, webhookError("admin redeliver webhook", err)
+	}
+
+	return &v1.AdminRedeliverWebhookResponse{
+		Delivery: conv.ConvertWebhookDeliveryToProto(d),
+	}, nil
+}
+
+func webhookError(op string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidWebhookURL):
+		return status.Error(codes.InvalidArgument, "webhook url must be an http(s) url of a public host")
+	case errors.Is(err, entity.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, "webhook not found")
+	case errors.Is(err, entity.ErrWebhookDeliveryNotFound):
+		return status.Error(codes.NotFound, "webhook delivery not found")
+	}
+
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
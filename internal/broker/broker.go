@@ -0,0 +1,226 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+var ErrUnknownPolicy = errors.New("unknown slow consumer policy")
+
+// Policy tells what to do with a subscriber whose queue is full.
+type Policy int
+
+const (
+	// PolicyDisconnect ends the subscription with entity.ErrSlowSubscriber,
+	// the subscriber resumes from the event log, so no event is lost.
+	PolicyDisconnect Policy = iota
+	// PolicyDropOldest drops the oldest queued event to make room,
+	// the subscriber never learns about the loss.
+	PolicyDropOldest
+	// PolicyBlock waits for the subscriber, delaying every other one.
+	PolicyBlock
+)
+
+func (p Policy) String() string {
+	switch p {
+	case PolicyDropOldest:
+		return "drop-oldest"
+	case PolicyDisconnect:
+		return "disconnect"
+	case PolicyBlock:
+		return "block"
+	default:
+		return ""
+	}
+}
+
+func ParsePolicy(s string) (Policy, error) {
+	switch s {
+	case "drop-oldest":
+		return PolicyDropOldest, nil
+	case "disconnect":
+		return PolicyDisconnect, nil
+	case "block":
+		return PolicyBlock, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownPolicy, s)
+	}
+}
+
+//go:generate options-gen -out-filename=broker_options.gen.go -from-struct=Options
+type Options struct {
+	queueSize int    `default:"256" validate:"min=1"`
+	policy    Policy `validate:"min=0,max=2"`
+}
+
+// Broker fans note events out to subscribers, every subscriber has its own
+// bounded queue, so a slow subscriber never makes others skip events.
+type Broker struct {
+	Options
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+
+	dropped      atomic.Int64
+	disconnected atomic.Int64
+}
+
+func New(opts Options) (*Broker, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate broker options: %v", err)
+	}
+
+	return &Broker{
+		Options: opts,
+		subs:    make(map[*Subscription]struct{}),
+	}, nil
+}
+
+// Subscribe queues published events the accept func returns true for.
+// The caller must Close the subscription.
+func (b *Broker) Subscribe(accept func(entity.NoteEvent) bool) *Subscription {
+	sub := &Subscription{
+		broker: b,
+		accept: accept,
+		events: make(chan entity.NoteEvent, b.queueSize),
+		done:   make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub
+}
+
+// Publish queues the event for every interested subscriber. With PolicyBlock
+// it waits for full queues until ctx is done.
+func (b *Broker) Publish(ctx context.Context, event entity.NoteEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		if !sub.accept(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+			continue
+		default:
+		}
+
+		switch b.policy {
+		case PolicyDropOldest:
+			b.dropOldest(sub, event)
+
+		case PolicyDisconnect:
+			b.disconnected.Add(1)
+			b.remove(sub, entity.ErrSlowSubscriber)
+			slogx.Warn(ctx, "disconnect slow note event subscriber")
+
+		case PolicyBlock:
+			select {
+			case sub.events <- event:
+			case <-sub.done:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// dropOldest makes room in the full queue, the subscriber may take an event
+// concurrently, so the oldest one is not necessarily dropped.
+func (b *Broker) dropOldest(sub *Subscription, event entity.NoteEvent) {
+	for {
+		select {
+		case sub.events <- event:
+			return
+		default:
+		}
+
+		select {
+		case <-sub.events:
+			b.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// remove must be called with the lock held.
+func (b *Broker) remove(sub *Subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+
+	delete(b.subs, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+// Stats is a snapshot of the broker state.
+type Stats struct {
+	Subscribers   int   `json:"subscribers"`
+	QueuedEvents  int   `json:"queued_events"`
+	MaxQueueDepth int   `json:"max_queue_depth"`
+	QueueSize     int   `json:"queue_size"`
+	Dropped       int64 `json:"dropped"`
+	Disconnected  int64 `json:"disconnected"`
+}
+
+func (b *Broker) Stats() Stats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := Stats{
+		Subscribers:  len(b.subs),
+		QueueSize:    b.queueSize,
+		Dropped:      b.dropped.Load(),
+		Disconnected: b.disconnected.Load(),
+	}
+	for sub := range b.subs {
+		depth := len(sub.events)
+		stats.QueuedEvents += depth
+		stats.MaxQueueDepth = max(stats.MaxQueueDepth, depth)
+	}
+
+	return stats
+}
+
+type Subscription struct {
+	broker *Broker
+	accept func(entity.NoteEvent) bool
+	events chan entity.NoteEvent
+	done   chan struct{}
+	once   sync.Once
+	err    error
+}
+
+// Events is closed when the broker disconnects the subscriber
+// or the subscription is closed.
+func (s *Subscription) Events() <-chan entity.NoteEvent {
+	return s.events
+}
+
+// Err tells why Events is closed, nil after Close.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		// unblocks a publisher waiting for this subscriber before taking the lock
+		close(s.done)
+
+		s.broker.mu.Lock()
+		s.broker.remove(s, nil)
+		s.broker.mu.Unlock()
+	})
+}
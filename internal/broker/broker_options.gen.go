@@ -0,0 +1,56 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package broker
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.queueSize = 256
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithQueueSize(opt int) OptOptionsSetter {
+	return func(o *Options) { o.queueSize = opt }
+}
+
+func WithPolicy(opt Policy) OptOptionsSetter {
+	return func(o *Options) { o.policy = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("queueSize", _validate_Options_queueSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("policy", _validate_Options_policy(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_queueSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.queueSize, "min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `queueSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_policy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.policy, "min=0,max=2"); err != nil {
+		return fmt461e464ebed9.Errorf("field `policy` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func TestMain(m *testing.M) {
+	slogx.SetDefault(slogx.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+func acceptAll(entity.NoteEvent) bool { return true }
+
+func newBroker(t *testing.T, policy Policy, queueSize int) *Broker {
+	t.Helper()
+
+	b, err := New(NewOptions(WithPolicy(policy), WithQueueSize(queueSize)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return b
+}
+
+func publish(b *Broker, sequences ...int64) {
+	for _, s := range sequences {
+		b.Publish(context.Background(), entity.NoteEvent{Sequence: s})
+	}
+}
+
+// drain returns sequences of the queued events without waiting for more.
+func drain(sub *Subscription) []int64 {
+	var sequences []int64
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return sequences
+			}
+			sequences = append(sequences, event.Sequence)
+		default:
+			return sequences
+		}
+	}
+}
+
+func TestBrokerDropOldest(t *testing.T) {
+	b := newBroker(t, PolicyDropOldest, 2)
+
+	stalled := b.Subscribe(acceptAll)
+	defer stalled.Close()
+
+	publish(b, 1, 2, 3, 4)
+
+	stats := b.Stats()
+	want := Stats{Subscribers: 1, QueuedEvents: 2, MaxQueueDepth: 2, QueueSize: 2, Dropped: 2}
+	if stats != want {
+		t.Errorf("Stats() = %+v, want %+v", stats, want)
+	}
+
+	if got := drain(stalled); !slices.Equal(got, []int64{3, 4}) {
+		t.Errorf("queued events = %v, want [3 4]", got)
+	}
+}
+
+func TestBrokerDisconnect(t *testing.T) {
+	b := newBroker(t, PolicyDisconnect, 1)
+
+	stalled := b.Subscribe(acceptAll)
+	defer stalled.Close()
+
+	// other subscribers keep their events
+	other := b.Subscribe(func(e entity.NoteEvent) bool { return e.Sequence == 2 })
+	defer other.Close()
+
+	publish(b, 1, 2, 3)
+
+	if got := drain(stalled); !slices.Equal(got, []int64{1}) {
+		t.Errorf("stalled subscriber events = %v, want [1]", got)
+	}
+	if _, ok := <-stalled.Events(); ok {
+		t.Error("stalled subscriber events are not closed")
+	}
+	if err := stalled.Err(); !errors.Is(err, entity.ErrSlowSubscriber) {
+		t.Errorf("Err() = %v, want %v", err, entity.ErrSlowSubscriber)
+	}
+
+	if got := drain(other); !slices.Equal(got, []int64{2}) {
+		t.Errorf("other subscriber events = %v, want [2]", got)
+	}
+
+	stats := b.Stats()
+	want := Stats{Subscribers: 1, QueueSize: 1, Disconnected: 1}
+	if stats != want {
+		t.Errorf("Stats() = %+v, want %+v", stats, want)
+	}
+}
+
+// TestBrokerDefaultPolicy checks that a slow subscriber is told about the loss
+// by default instead of silently missing events.
+func TestBrokerDefaultPolicy(t *testing.T) {
+	b, err := New(NewOptions(WithQueueSize(1)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	stalled := b.Subscribe(acceptAll)
+	defer stalled.Close()
+
+	publish(b, 1, 2)
+
+	if got := drain(stalled); !slices.Equal(got, []int64{1}) {
+		t.Errorf("stalled subscriber events = %v, want [1]", got)
+	}
+	if err := stalled.Err(); !errors.Is(err, entity.ErrSlowSubscriber) {
+		t.Errorf("Err() = %v, want %v", err, entity.ErrSlowSubscriber)
+	}
+	if dropped := b.Stats().Dropped; dropped != 0 {
+		t.Errorf("Stats().Dropped = %d, want 0", dropped)
+	}
+}
+
+func TestBrokerBlock(t *testing.T) {
+	b := newBroker(t, PolicyBlock, 1)
+
+	stalled := b.Subscribe(acceptAll)
+	defer stalled.Close()
+
+	publish(b, 1)
+
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		publish(b, 2)
+	}()
+
+	select {
+	case <-published:
+		t.Fatal("Publish() returned while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if got := (<-stalled.Events()).Sequence; got != 1 {
+		t.Errorf("first event = %d, want 1", got)
+	}
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("Publish() is blocked after the subscriber took an event")
+	}
+
+	if got := drain(stalled); !slices.Equal(got, []int64{2}) {
+		t.Errorf("queued events = %v, want [2]", got)
+	}
+
+	stats := b.Stats()
+	want := Stats{Subscribers: 1, QueueSize: 1}
+	if stats != want {
+		t.Errorf("Stats() = %+v, want %+v", stats, want)
+	}
+}
+
+func TestBrokerBlockUnblocks(t *testing.T) {
+	tests := []struct {
+		name    string
+		unblock func(cancel context.CancelFunc, sub *Subscription)
+	}{
+		{
+			name:    "context done",
+			unblock: func(cancel context.CancelFunc, _ *Subscription) { cancel() },
+		},
+		{
+			name:    "subscription closed",
+			unblock: func(_ context.CancelFunc, sub *Subscription) { sub.Close() },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBroker(t, PolicyBlock, 1)
+
+			stalled := b.Subscribe(acceptAll)
+			defer stalled.Close()
+
+			publish(b, 1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			published := make(chan struct{})
+			go func() {
+				defer close(published)
+				b.Publish(ctx, entity.NoteEvent{Sequence: 2})
+			}()
+
+			tt.unblock(cancel, stalled)
+
+			select {
+			case <-published:
+			case <-time.After(time.Second):
+				t.Fatal("Publish() is still blocked")
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	for _, p := range []Policy{PolicyDropOldest, PolicyDisconnect, PolicyBlock} {
+		got, err := ParsePolicy(p.String())
+		if err != nil || got != p {
+			t.Errorf("ParsePolicy(%q) = %v, %v, want %v", p.String(), got, err, p)
+		}
+	}
+
+	if _, err := ParsePolicy("drop-newest"); !errors.Is(err, ErrUnknownPolicy) {
+		t.Errorf("ParsePolicy() error = %v, want %v", err, ErrUnknownPolicy)
+	}
+}
@@ -3,11 +3,19 @@ package config
 import "time"
 
 type Config struct {
-	App         AppConfig      `env-prefix:"APP_"`
-	HTTP        HTTPConfig     `env-prefix:"HTTP_"`
-	SwaggerHTTP HTTPConfig     `env-prefix:"SWAGGER_HTTP_"`
-	GRPC        GRPCConfig     `env-prefix:"GRPC_"`
-	Database    DatabaseConfig `env-prefix:"DB_"`
+	App         AppConfig         `env-prefix:"APP_"`
+	HTTP        HTTPConfig        `env-prefix:"HTTP_"`
+	SwaggerHTTP HTTPConfig        `env-prefix:"SWAGGER_HTTP_"`
+	GRPC        GRPCConfig        `env-prefix:"GRPC_"`
+	Database    DatabaseConfig    `env-prefix:"DB_"`
+	Trash       TrashConfig       `env-prefix:"TRASH_"`
+	Attachments AttachmentsConfig `env-prefix:"ATTACHMENTS_"`
+	Events      EventsConfig      `env-prefix:"EVENTS_"`
+	Webhooks    WebhooksConfig    `env-prefix:"WEBHOOKS_"`
+	Metrics     MetricsConfig     `env-prefix:"METRICS_"`
+	Editing     EditingConfig     `env-prefix:"EDITING_"`
+	Presence    PresenceConfig    `env-prefix:"PRESENCE_"`
+	Auth        AuthConfig        `env-prefix:"AUTH_"`
 }
 
 type HTTPConfig struct {
@@ -33,3 +41,69 @@ type DatabaseConfig struct {
 	User     string `env:"USER" env-default:"user"`
 	Password string `env:"PASSWORD"`
 }
+
+type TrashConfig struct {
+	Retention     time.Duration `env:"RETENTION" env-default:"720h"`
+	PurgeInterval time.Duration `env:"PURGE_INTERVAL" env-default:"1h"`
+}
+
+type MetricsConfig struct {
+	BatchSize int `env:"BATCH_SIZE" env-default:"500"`
+	// Retention bounds the window in which retried uploads are deduplicated.
+	Retention time.Duration `env:"RETENTION" env-default:"168h"`
+}
+
+type EditingConfig struct {
+	SnapshotInterval time.Duration `env:"SNAPSHOT_INTERVAL" env-default:"5s"`
+	// Retention bounds how far behind edit session participants may lag.
+	Retention time.Duration `env:"RETENTION" env-default:"24h"`
+}
+
+type PresenceConfig struct {
+	// TTL has to outlast a few 5s stream heartbeats.
+	TTL time.Duration `env:"TTL" env-default:"15s"`
+}
+
+// AuthConfig sets up keys verifying JWT bearer tokens, at least one of
+// them is required. The token subject is the user id.
+type AuthConfig struct {
+	// HMACSecret verifies HS256 tokens.
+	HMACSecret string `env:"HMAC_SECRET"`
+	// PublicKeyFile is a PEM RSA or P-256 key verifying RS256 or ES256 tokens.
+	PublicKeyFile string `env:"PUBLIC_KEY_FILE"`
+	// JWKSFile is a local JSON Web Key Set, tokens pick a key with kid.
+	JWKSFile string        `env:"JWKS_FILE"`
+	Issuer   string        `env:"ISSUER"`
+	Audience string        `env:"AUDIENCE"`
+	Leeway   time.Duration `env:"LEEWAY" env-default:"30s"`
+}
+
+type AttachmentsConfig struct {
+	Dir     string `env:"DIR" env-default:"./data/attachments"`
+	MaxSize int64  `env:"MAX_SIZE" env-default:"26214400"`
+}
+
+type EventsConfig struct {
+	QueueSize int `env:"QUEUE_SIZE" env-default:"256"`
+	// SlowConsumerPolicy is one of disconnect, drop-oldest or block.
+	SlowConsumerPolicy string `env:"SLOW_CONSUMER_POLICY" env-default:"disconnect"`
+	// Retention bounds how far back subscribers may resume.
+	Retention time.Duration `env:"RETENTION" env-default:"168h"`
+}
+
+type WebhooksConfig struct {
+	Workers       int           `env:"WORKERS" env-default:"4"`
+	PollInterval  time.Duration `env:"POLL_INTERVAL" env-default:"1s"`
+	Timeout       time.Duration `env:"TIMEOUT" env-default:"10s"`
+	RetryAttempts int           `env:"RETRY_ATTEMPTS" env-default:"5"`
+	RetryDelay    time.Duration `env:"RETRY_DELAY" env-default:"1s"`
+	RetryMaxDelay time.Duration `env:"RETRY_MAX_DELAY" env-default:"1h"`
+	// RetryMaxJitter bounds the random delay added to every retry.
+	RetryMaxJitter time.Duration `env:"RETRY_MAX_JITTER" env-default:"1s"`
+	// Lease is how long a delivery attempt may take before the delivery
+	// is attempted again, it must be longer than Timeout.
+	Lease time.Duration `env:"LEASE" env-default:"1m"`
+	// AllowPrivateAddresses lets webhooks call loopback and private
+	// addresses, for local development only.
+	AllowPrivateAddresses bool `env:"ALLOW_PRIVATE_ADDRESSES" env-default:"false"`
+}
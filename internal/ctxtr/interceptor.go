@@ -3,8 +3,14 @@ package ctxtr
 import (
 	"context"
 	"errors"
+	"strconv"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/jwtx"
 )
 
 type ctxKey string
@@ -13,16 +19,51 @@ const UserIDKey ctxKey = "user_id"
 
 var ErrUserNotFound =  errors.New("user not found")
 
-func MockAuthInterceptor(userID int64) grpc.UnaryServerInterceptor {
-    return func(
-        ctx context.Context,
-        req any,
-        info *grpc.UnaryServerInfo,
-        handler grpc.UnaryHandler,
-    ) (any, error) {
-        ctx = context.WithValue(ctx, UserIDKey, userID)
-        return handler(ctx, req)
-    }
+// UserInterceptor puts the subject of the verified token into the context
+// as the user id. Calls of public methods carry no token and no user.
+func UserInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := withUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func withUserID(ctx context.Context) (context.Context, error) {
+	claims, ok := jwtx.FromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "token subject %q is not a user id", claims.Subject)
+	}
+
+	return context.WithValue(ctx, UserIDKey, userID), nil
+}
+
+// UserStreamInterceptor is UserInterceptor for streaming methods.
+func UserStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := withUserID(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	wrapped := middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = ctx
+	return handler(srv, wrapped)
 }
 
 func UserID(ctx context.Context) (int64, error) {
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentTooLarge     = errors.New("attachment is too large")
+	ErrAttachmentSizeMismatch = errors.New("attachment size does not match the declared one")
+)
+
+// Attachment is a file attached to a note. The content lives in the blob
+// storage under StorageKey.
+type Attachment struct {
+	ID          int64
+	NoteID      int64
+	StorageKey  string
+	Filename    string
+	ContentType string
+	Size        int64
+	SHA256      []byte
+	CreatedBy   int64
+	CreatedAt   time.Time
+}
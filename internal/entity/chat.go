@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSlowChatParticipant = errors.New("chat participant is too slow")
+
+// ChatMessage is a message of the discussion of a note.
+type ChatMessage struct {
+	ID        int64
+	NoteID    int64
+	UserID    int64
+	Content   string
+	CreatedAt time.Time
+}
+
+type ChatMessagesQuery struct {
+	UserID   int64
+	NoteID   int64
+	PageSize int
+
+	// Before is the last message id of the previous page, 0 for the first page.
+	Before int64
+}
+
+type ChatMessagesPage struct {
+	Messages []ChatMessage
+	Next     int64
+}
+
+// ChatRoom delivers messages posted to the room of a note to one participant.
+type ChatRoom interface {
+	// Messages is closed when the participant leaves the room.
+	Messages() <-chan ChatMessage
+	// Err tells why the participant left, nil when it was canceled.
+	Err() error
+}
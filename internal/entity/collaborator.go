@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoteAccessDenied     = errors.New("note access denied")
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	ErrShareWithOwner       = errors.New("note cannot be shared with its owner")
+	ErrUnknownNoteRole      = errors.New("unknown note role")
+)
+
+// NoteRole is an access level to a note, every role includes the lower ones.
+type NoteRole int
+
+const (
+	NoteRoleNone NoteRole = iota
+	NoteRoleViewer
+	NoteRoleEditor
+	NoteRoleOwner
+)
+
+func (r NoteRole) String() string {
+	switch r {
+	case NoteRoleViewer:
+		return "viewer"
+	case NoteRoleEditor:
+		return "editor"
+	case NoteRoleOwner:
+		return "owner"
+	default:
+		return ""
+	}
+}
+
+func ParseNoteRole(s string) (NoteRole, error) {
+	switch s {
+	case "":
+		return NoteRoleNone, nil
+	case "viewer":
+		return NoteRoleViewer, nil
+	case "editor":
+		return NoteRoleEditor, nil
+	case "owner":
+		return NoteRoleOwner, nil
+	default:
+		return NoteRoleNone, ErrUnknownNoteRole
+	}
+}
+
+// Allows reports whether the role grants at least the required access.
+func (r NoteRole) Allows(required NoteRole) bool {
+	return r >= required
+}
+
+type Collaborator struct {
+	NoteID    int64
+	UserID    int64
+	Role      NoteRole
+	CreatedAt time.Time
+}
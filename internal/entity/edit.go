@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/ot"
+)
+
+var (
+	ErrUnknownNoteEditKind  = errors.New("unknown note edit kind")
+	ErrInvalidEditOperation = errors.New("invalid edit operation")
+	ErrInvalidEditCursor    = errors.New("invalid edit cursor")
+	// ErrEditVersionGone is returned for operations made on a version
+	// whose history is purged, the participant has to rejoin.
+	ErrEditVersionGone     = errors.New("edit version is gone")
+	ErrSlowEditParticipant = errors.New("edit participant is too slow")
+)
+
+type NoteEditKind int
+
+const (
+	NoteEditOperation NoteEditKind = iota + 1
+	NoteEditCursor
+	NoteEditLeave
+)
+
+func (k NoteEditKind) String() string {
+	switch k {
+	case NoteEditOperation:
+		return "operation"
+	case NoteEditCursor:
+		return "cursor"
+	case NoteEditLeave:
+		return "leave"
+	default:
+		return ""
+	}
+}
+
+func ParseNoteEditKind(s string) (NoteEditKind, error) {
+	switch s {
+	case "operation":
+		return NoteEditOperation, nil
+	case "cursor":
+		return NoteEditCursor, nil
+	case "leave":
+		return NoteEditLeave, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownNoteEditKind, s)
+	}
+}
+
+// NoteDocument is the content of a note shared by an edit session.
+// It is saved to the note when the snapshot version is behind.
+type NoteDocument struct {
+	NoteID          int64
+	Content         string
+	Version         int64
+	NoteRevision    int64
+	SnapshotVersion int64
+	LastEditorID    int64
+	UpdatedAt       time.Time
+}
+
+func (d NoteDocument) Dirty() bool {
+	return d.Version > d.SnapshotVersion
+}
+
+type EditCursor struct {
+	Position     int
+	SelectionEnd int
+}
+
+// Transform moves the cursor over the operation.
+func (c EditCursor) Transform(op ot.Operation) EditCursor {
+	return EditCursor{
+		Position:     ot.TransformIndex(c.Position, op),
+		SelectionEnd: ot.TransformIndex(c.SelectionEnd, op),
+	}
+}
+
+// NoteEdit is an entry of the edit log of a note. Version is the one
+// produced by an operation or the one a cursor refers to.
+type NoteEdit struct {
+	ID        int64
+	NoteID    int64
+	SessionID uuid.UUID
+	UserID    int64
+	Kind      NoteEditKind
+	Version   int64
+	Operation ot.Operation
+	Cursor    EditCursor
+	CreatedAt time.Time
+}
+
+// EditSnapshot is the state of the document a participant joins with.
+type EditSnapshot struct {
+	SessionID uuid.UUID
+	Content   string
+	Version   int64
+	// Cursors of other participants moved to the version.
+	Cursors []NoteEdit
+}
+
+// NoteEditStream delivers edits of other participants of the session.
+type NoteEditStream interface {
+	// Edits is closed when the participant leaves the session.
+	Edits() <-chan NoteEdit
+	// Err tells why the participant left, nil when it was canceled.
+	Err() error
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrUnknownNoteEventType = errors.New("unknown note event type")
+	ErrSlowSubscriber       = errors.New("note event subscriber is too slow")
+)
+
+type NoteEventType int
+
+const (
+	NoteEventCreated NoteEventType = iota + 1
+	NoteEventUpdated
+	NoteEventDeleted
+	NoteEventRestored
+	NoteEventShared
+	NoteEventPresenceJoined
+	NoteEventPresenceLeft
+)
+
+func (t NoteEventType) String() string {
+	switch t {
+	case NoteEventCreated:
+		return "created"
+	case NoteEventUpdated:
+		return "updated"
+	case NoteEventDeleted:
+		return "deleted"
+	case NoteEventRestored:
+		return "restored"
+	case NoteEventShared:
+		return "shared"
+	case NoteEventPresenceJoined:
+		return "presence_joined"
+	case NoteEventPresenceLeft:
+		return "presence_left"
+	default:
+		return ""
+	}
+}
+
+func ParseNoteEventType(s string) (NoteEventType, error) {
+	switch s {
+	case "created":
+		return NoteEventCreated, nil
+	case "updated":
+		return NoteEventUpdated, nil
+	case "deleted":
+		return NoteEventDeleted, nil
+	case "restored":
+		return NoteEventRestored, nil
+	case "shared":
+		return NoteEventShared, nil
+	case "presence_joined":
+		return NoteEventPresenceJoined, nil
+	case "presence_left":
+		return NoteEventPresenceLeft, nil
+	default:
+		return 0, ErrUnknownNoteEventType
+	}
+}
+
+// NoteEvent is a change of a note delivered to note subscribers.
+// Sequence orders events in the durable event log.
+type NoteEvent struct {
+	Sequence  int64
+	Type      NoteEventType
+	Note      Note
+	CreatedAt time.Time
+
+	// Collaborator is set for NoteEventShared.
+	Collaborator Collaborator
+
+	// Presence is the session that joined or left for presence events.
+	Presence PresenceSession
+
+	// Recipients are the owner and collaborators of the note
+	// at the moment of the event.
+	Recipients []int64
+}
+
+func (e NoteEvent) VisibleTo(userID int64) bool {
+	return slices.Contains(e.Recipients, userID)
+}
+
+type NoteEventsQuery struct {
+	UserID        int64
+	AfterSequence int64
+	PageSize      int
+}
+
+// NoteEventStream delivers note events to one subscriber.
+type NoteEventStream interface {
+	// Events is closed when the stream ends.
+	Events() <-chan NoteEvent
+	// Err tells why the stream ended, nil when it was canceled.
+	Err() error
+}
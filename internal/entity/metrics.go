@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidStatsRange = errors.New("invalid stats range")
+
+// NoteView is a number of views of a note reported by a client.
+type NoteView struct {
+	NoteID   int64
+	ViewedAt time.Time
+	Count    int64
+}
+
+// MetricRecord is a NoteView uploaded as a part of a batch. BatchID and
+// Sequence identify the record, so a retried upload is not counted twice.
+type MetricRecord struct {
+	BatchID  uuid.UUID
+	Sequence int64
+	NoteView
+}
+
+// MetricsSummary counts the records of an upload. Views sums the views
+// of the accepted records.
+type MetricsSummary struct {
+	Views      int64
+	Accepted   int64
+	Duplicated int64
+	Rejected   int64
+}
+
+// StatsGranularity is the length of a stats bucket, buckets are aligned
+// to hours and days in UTC.
+type StatsGranularity int
+
+const (
+	StatsGranularityHour StatsGranularity = iota + 1
+	StatsGranularityDay
+)
+
+func (g StatsGranularity) Duration() time.Duration {
+	if g == StatsGranularityDay {
+		return 24 * time.Hour
+	}
+
+	return time.Hour
+}
+
+// Truncate returns the start of the bucket t falls into.
+func (g StatsGranularity) Truncate(t time.Time) time.Time {
+	return t.UTC().Truncate(g.Duration())
+}
+
+// NoteStatsQuery selects views of the note in [From, To).
+type NoteStatsQuery struct {
+	UserID      int64
+	NoteID      int64
+	Granularity StatsGranularity
+	From        time.Time
+	To          time.Time
+}
+
+type ViewBucket struct {
+	Start time.Time
+	Views int64
+}
+
+type NoteStats struct {
+	Buckets    []ViewBucket
+	TotalViews int64
+}
+
+// TopNotesQuery selects the most viewed notes visible to the user
+// in [From, To).
+type TopNotesQuery struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+	Limit  int
+}
+
+type NoteViews struct {
+	Note  Note
+	Views int64
+}
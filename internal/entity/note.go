@@ -5,7 +5,10 @@ import (
 	"time"
 )
 
-var ErrNoteNotFound = errors.New("note not found")
+var (
+	ErrNoteNotFound         = errors.New("note not found")
+	ErrNoteRevisionMismatch = errors.New("note revision mismatch")
+)
 
 type Note struct {
 	ID        int64
@@ -14,8 +17,21 @@ type Note struct {
 	Content   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Revision  int64
+	DeletedAt time.Time
+	Tags      []string
+
+	// NotebookID is 0 for notes outside of any notebook.
+	NotebookID int64
 }
 
-type CreateNoteEvent struct {
-	CreatedNote Note
+// NoteUpdate describes a partial note update, nil fields are left unchanged.
+// Revision is the note revision the caller expects to overwrite.
+// Tags replace the note tags when not nil, an empty slice clears them.
+type NoteUpdate struct {
+	Revision int64
+	EditorID int64
+	Title    *string
+	Content  *string
+	Tags     []string
 }
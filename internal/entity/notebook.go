@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotebookNotFound = errors.New("notebook not found")
+	ErrNotebookCycle    = errors.New("notebook cannot be moved into its own subtree")
+)
+
+// Notebook groups notes, notebooks nest into a tree via ParentID.
+// Path lists ids from the root down to the notebook itself, e.g. /1/5/.
+type Notebook struct {
+	ID        int64
+	UserID    int64
+	ParentID  int64
+	Name      string
+	Path      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotebookUpdate describes a partial notebook update, nil fields are left
+// unchanged. ParentID pointing to 0 moves the notebook to the root.
+type NotebookUpdate struct {
+	Name     *string
+	ParentID *int64
+}
+
+// NotebookTree is a notebook with its sub-notebooks. NoteCount counts notes
+// of the notebook itself, TotalNoteCount includes the whole subtree.
+type NotebookTree struct {
+	Notebook       Notebook
+	NoteCount      int64
+	TotalNoteCount int64
+	Children       []NotebookTree
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+type NoteOrderBy int
+
+const (
+	NoteOrderByCreatedAt NoteOrderBy = iota
+	NoteOrderByUpdatedAt
+	NoteOrderByTitle
+	NoteOrderByDeletedAt
+)
+
+// NotesQuery selects a page of user notes. Zero time bounds are not applied,
+// After points to the last note of the previous page.
+type NotesQuery struct {
+	UserID     int64
+	PageSize   int
+	OrderBy    NoteOrderBy
+	Descending bool
+
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+
+	// Tags keeps notes having any of the tags, or all of them with MatchAllTags.
+	Tags         []string
+	MatchAllTags bool
+
+	After *NoteCursor
+}
+
+// NoteCursor is a keyset position: the sort key of a note and its id.
+type NoteCursor struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+	Title     string    `json:"title,omitempty"`
+	DeletedAt time.Time `json:"deleted_at,omitzero"`
+}
+
+func NewNoteCursor(note Note, orderBy NoteOrderBy) *NoteCursor {
+	cursor := NoteCursor{ID: note.ID}
+
+	switch orderBy {
+	case NoteOrderByUpdatedAt:
+		cursor.UpdatedAt = note.UpdatedAt
+	case NoteOrderByTitle:
+		cursor.Title = note.Title
+	case NoteOrderByDeletedAt:
+		cursor.DeletedAt = note.DeletedAt
+	default:
+		cursor.CreatedAt = note.CreatedAt
+	}
+
+	return &cursor
+}
+
+type NotesPage struct {
+	Notes []Note
+	Next  *NoteCursor
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownPresenceActivity = errors.New("unknown presence activity")
+	ErrPresenceExpired         = errors.New("presence session expired")
+)
+
+type PresenceActivity int
+
+const (
+	PresenceViewing PresenceActivity = iota + 1
+	PresenceChatting
+	PresenceEditing
+)
+
+func (a PresenceActivity) String() string {
+	switch a {
+	case PresenceViewing:
+		return "viewing"
+	case PresenceChatting:
+		return "chatting"
+	case PresenceEditing:
+		return "editing"
+	default:
+		return ""
+	}
+}
+
+func ParsePresenceActivity(s string) (PresenceActivity, error) {
+	switch s {
+	case "viewing":
+		return PresenceViewing, nil
+	case "chatting":
+		return PresenceChatting, nil
+	case "editing":
+		return PresenceEditing, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownPresenceActivity, s)
+	}
+}
+
+// PresenceSession marks the user present on the note while a stream lasts.
+// The stream refreshes it before it expires.
+type PresenceSession struct {
+	NoteID    int64
+	SessionID uuid.UUID
+	UserID    int64
+	Activity  PresenceActivity
+	JoinedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Presence sums up sessions of a user on a note.
+type Presence struct {
+	UserID     int64
+	Activities []PresenceActivity
+	JoinedAt   time.Time
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrNoteRevisionNotFound = errors.New("note revision not found")
+
+// NoteRevision is a snapshot of a note saved on every create and update.
+type NoteRevision struct {
+	NoteID    int64
+	Revision  int64
+	AuthorID  int64
+	Title     string
+	Content   string
+	CreatedAt time.Time
+}
+
+type NoteRevisionsQuery struct {
+	UserID   int64
+	NoteID   int64
+	PageSize int
+
+	// Before is the last revision of the previous page, 0 for the first page.
+	Before int64
+}
+
+type NoteRevisionsPage struct {
+	Revisions []NoteRevision
+	Next      int64
+}
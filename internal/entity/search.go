@@ -0,0 +1,44 @@
+package entity
+
+// SearchLanguage is a PostgreSQL text search configuration name.
+type SearchLanguage string
+
+const (
+	SearchLanguageSimple  SearchLanguage = "simple"
+	SearchLanguageEnglish SearchLanguage = "english"
+	SearchLanguageRussian SearchLanguage = "russian"
+)
+
+type SearchQuery struct {
+	UserID   int64
+	Query    string
+	Language SearchLanguage
+	PageSize int
+
+	After *SearchCursor
+}
+
+// SearchCursor is a keyset position in results ordered by rank.
+type SearchCursor struct {
+	ID   int64   `json:"id"`
+	Rank float32 `json:"rank"`
+}
+
+type SearchResult struct {
+	Note    Note
+	Rank    float32
+	Snippet string
+	// Highlights are the matched terms in Snippet.
+	Highlights []TextRange
+}
+
+// TextRange is a half-open range of rune offsets in a text.
+type TextRange struct {
+	Start int
+	End   int
+}
+
+type SearchPage struct {
+	Results []SearchResult
+	Next    *SearchCursor
+}
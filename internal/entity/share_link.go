@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrShareLinkNotFound        = errors.New("share link not found")
+	ErrShareLinkPasswordInvalid = errors.New("share link password is invalid")
+)
+
+// ShareLink gives read-only access to a note by an unguessable token.
+// Only the token hash is stored, Token is set right after creation.
+type ShareLink struct {
+	ID           int64
+	NoteID       int64
+	Token        string
+	TokenHash    []byte
+	PasswordHash string
+	ExpiresAt    time.Time
+	RevokedAt    time.Time
+	CreatedBy    int64
+	CreatedAt    time.Time
+}
+
+func (l ShareLink) HasPassword() bool {
+	return l.PasswordHash != ""
+}
+
+// SharedNote is a note opened by a share link.
+type SharedNote struct {
+	Note         Note
+	PasswordHash string
+	ExpiresAt    time.Time
+}
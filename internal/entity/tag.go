@@ -0,0 +1,14 @@
+package entity
+
+import "errors"
+
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+)
+
+type Tag struct {
+	ID        int64
+	Name      string
+	NoteCount int64
+}
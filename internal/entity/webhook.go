@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrWebhookNotFound              = errors.New("webhook not found")
+	ErrInvalidWebhookURL            = errors.New("invalid webhook url")
+	ErrWebhookDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrUnknownWebhookDeliveryStatus = errors.New("unknown webhook delivery status")
+)
+
+// Webhook is an HTTP endpoint called on events of the notes visible to
+// the user. An empty EventTypes subscribes to every event type.
+type Webhook struct {
+	ID         int64
+	UserID     int64
+	URL        string
+	Secret     string
+	EventTypes []NoteEventType
+	CreatedAt  time.Time
+}
+
+type WebhookDeliveryStatus int
+
+const (
+	WebhookDeliveryPending WebhookDeliveryStatus = iota + 1
+	WebhookDeliverySucceeded
+	WebhookDeliveryFailed
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	switch s {
+	case WebhookDeliveryPending:
+		return "pending"
+	case WebhookDeliverySucceeded:
+		return "succeeded"
+	case WebhookDeliveryFailed:
+		return "failed"
+	default:
+		return ""
+	}
+}
+
+func ParseWebhookDeliveryStatus(s string) (WebhookDeliveryStatus, error) {
+	switch s {
+	case "pending":
+		return WebhookDeliveryPending, nil
+	case "succeeded":
+		return WebhookDeliverySucceeded, nil
+	case "failed":
+		return WebhookDeliveryFailed, nil
+	default:
+		return 0, ErrUnknownWebhookDeliveryStatus
+	}
+}
+
+// WebhookDelivery is a call of a webhook for one note event, it keeps the
+// outcome of the last attempt. ResponseStatus is 0 if no response was
+// received. A pending delivery is attempted again at NextAttemptAt.
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventSequence  int64
+	EventType      NoteEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	CreatedAt      time.Time
+	CompletedAt    time.Time
+	NextAttemptAt  time.Time
+}
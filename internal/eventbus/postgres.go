@@ -0,0 +1,95 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const relayPageSize = 500
+
+type eventLog interface {
+	GetLastNoteEventSequence(ctx context.Context) (int64, error)
+	ListNoteEventsAfter(ctx context.Context, after int64, limit int) ([]entity.NoteEvent, error)
+}
+
+type listener interface {
+	Listen(ctx context.Context, channel string, handle func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=postgres_options.gen.go -from-struct=Options
+type Options struct {
+	log      eventLog `option:"mandatory" validate:"required"`
+	listener listener `option:"mandatory" validate:"required"`
+	channel  string   `option:"mandatory" validate:"required"`
+
+	reconnectDelay time.Duration `default:"1s" validate:"min=10ms"`
+}
+
+// Postgres delivers note events committed by every server instance. Writers
+// append events to the log and notify the channel in their transaction, the
+// bus wakes up on notifications and reads the log after the last delivered
+// sequence, so events missed while reconnecting are delivered as well.
+type Postgres struct {
+	Options
+}
+
+func New(opts Options) (*Postgres, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate event bus options: %v", err)
+	}
+
+	return &Postgres{Options: opts}, nil
+}
+
+// Run delivers events committed after its start in sequence order
+// until ctx is done.
+func (p *Postgres) Run(ctx context.Context, deliver func(entity.NoteEvent)) error {
+	last, err := p.log.GetLastNoteEventSequence(ctx)
+	if err != nil {
+		return fmt.Errorf("start event bus: %v", err)
+	}
+
+	slogx.Info(ctx, "run note event bus")
+
+	for {
+		err := p.listener.Listen(ctx, p.channel, func(ctx context.Context) error {
+			var err error
+			last, err = p.relay(ctx, last, deliver)
+			return err
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		slogx.Warn(ctx, "note event bus disconnected", slogx.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(p.reconnectDelay):
+		}
+	}
+}
+
+// relay delivers logged events after the sequence and returns the last one.
+func (p *Postgres) relay(ctx context.Context, last int64, deliver func(entity.NoteEvent)) (int64, error) {
+	for {
+		events, err := p.log.ListNoteEventsAfter(ctx, last, relayPageSize)
+		if err != nil {
+			return last, err
+		}
+
+		for _, event := range events {
+			deliver(event)
+			last = event.Sequence
+		}
+
+		if len(events) < relayPageSize {
+			return last, nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package eventbus
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	log eventLog,
+	listener listener,
+	channel string,
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.reconnectDelay, _ = time.ParseDuration("1s")
+
+	o.log = log
+	o.listener = listener
+	o.channel = channel
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithReconnectDelay(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.reconnectDelay = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("log", _validate_Options_log(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("listener", _validate_Options_listener(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("channel", _validate_Options_channel(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reconnectDelay", _validate_Options_reconnectDelay(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_log(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.log, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `log` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_listener(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.listener, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `listener` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_channel(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.channel, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `channel` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reconnectDelay(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reconnectDelay, "min=10ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reconnectDelay` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package purger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+type trashUsecase interface {
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error)
+	PurgeMetricRecords(ctx context.Context, receivedBefore time.Time) (int64, error)
+	PurgeNoteEdits(ctx context.Context, createdBefore time.Time) (int64, error)
+	PurgeNoteEvents(ctx context.Context, createdBefore time.Time) (int64, error)
+}
+
+//go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.55.3 -out-filename=purger_options.gen.go -from-struct=Options
+type Options struct {
+	usecase trashUsecase `option:"mandatory" validate:"required"`
+
+	retention time.Duration `default:"720h" validate:"min=1m"`
+	interval  time.Duration `default:"1h" validate:"min=1s"`
+	// metricsRetention is how long uploaded metric records are kept
+	// to deduplicate retried uploads.
+	metricsRetention time.Duration `default:"168h" validate:"min=1m"`
+	// editsRetention is how long saved edit operations are kept
+	// to transform operations of lagging edit session participants.
+	editsRetention time.Duration `default:"24h" validate:"min=1m"`
+	// eventsRetention is how long note events are kept
+	// to replay them to resuming subscribers.
+	eventsRetention time.Duration `default:"168h" validate:"min=1m"`
+}
+
+// Purger periodically removes notes that stayed in the trash longer
+// than the retention period, metric records older than the metrics
+// retention period, saved note edits older than the edits retention
+// and note events older than the events retention.
+type Purger struct {
+	Options
+}
+
+func New(opts Options) (*Purger, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate purger options: %v", err)
+	}
+
+	return &Purger{Options: opts}, nil
+}
+
+func (p *Purger) Run(ctx context.Context) error {
+	slogx.Info(ctx, "run trash purger",
+		slog.Duration("retention", p.retention),
+		slog.Duration("interval", p.interval),
+		slog.Duration("metrics_retention", p.metricsRetention),
+		slog.Duration("edits_retention", p.editsRetention),
+		slog.Duration("events_retention", p.eventsRetention),
+	)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Purger) purge(ctx context.Context) {
+	p.purgeTrash(ctx)
+	p.purgeMetricRecords(ctx)
+	p.purgeNoteEdits(ctx)
+	p.purgeNoteEvents(ctx)
+}
+
+func (p *Purger) purgeTrash(ctx context.Context) {
+	purged, err := p.usecase.PurgeTrash(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			slogx.Error(ctx, "purge trash", slogx.Err(err))
+		}
+		return
+	}
+
+	if purged > 0 {
+		slogx.Info(ctx, "success to purge trash", slog.Int64("purged", purged))
+	}
+}
+
+func (p *Purger) purgeMetricRecords(ctx context.Context) {
+	purged, err := p.usecase.PurgeMetricRecords(ctx, time.Now().Add(-p.metricsRetention))
+	if err != nil {
+		if ctx.Err() == nil {
+			slogx.Error(ctx, "purge metric records", slogx.Err(err))
+		}
+		return
+	}
+
+	if purged > 0 {
+		slogx.Info(ctx, "success to purge metric records", slog.Int64("purged", purged))
+	}
+}
+
+func (p *Purger) purgeNoteEdits(ctx context.Context) {
+	purged, err := p.usecase.PurgeNoteEdits(ctx, time.Now().Add(-p.editsRetention))
+	if err != nil {
+		if ctx.Err() == nil {
+			slogx.Error(ctx, "purge note edits", slogx.Err(err))
+		}
+		return
+	}
+
+	if purged > 0 {
+		slogx.Info(ctx, "success to purge note edits", slog.Int64("purged", purged))
+	}
+}
+
+func (p *Purger) purgeNoteEvents(ctx context.Context) {
+	purged, err := p.usecase.PurgeNoteEvents(ctx, time.Now().Add(-p.eventsRetention))
+	if err != nil {
+		if ctx.Err() == nil {
+			slogx.Error(ctx, "purge note events", slogx.Err(err))
+		}
+		return
+	}
+
+	if purged > 0 {
+		slogx.Info(ctx, "success to purge note events", slog.Int64("purged", purged))
+	}
+}
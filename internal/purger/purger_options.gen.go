@@ -0,0 +1,114 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package purger
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	usecase trashUsecase,
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.retention, _ = time.ParseDuration("720h")
+	o.interval, _ = time.ParseDuration("1h")
+	o.metricsRetention, _ = time.ParseDuration("168h")
+	o.editsRetention, _ = time.ParseDuration("24h")
+	o.eventsRetention, _ = time.ParseDuration("168h")
+
+	o.usecase = usecase
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithRetention(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.retention = opt }
+}
+
+func WithInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.interval = opt }
+}
+
+// metricsRetention is how long uploaded metric records are kept
+// to deduplicate retried uploads.
+func WithMetricsRetention(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.metricsRetention = opt }
+}
+
+// editsRetention is how long saved edit operations are kept
+// to transform operations of lagging edit session participants.
+func WithEditsRetention(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.editsRetention = opt }
+}
+
+// eventsRetention is how long note events are kept
+// to replay them to resuming subscribers.
+func WithEventsRetention(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.eventsRetention = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("usecase", _validate_Options_usecase(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retention", _validate_Options_retention(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("interval", _validate_Options_interval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("metricsRetention", _validate_Options_metricsRetention(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editsRetention", _validate_Options_editsRetention(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("eventsRetention", _validate_Options_eventsRetention(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_usecase(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.usecase, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `usecase` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retention(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retention, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retention` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_interval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.interval, "min=1s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `interval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_metricsRetention(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.metricsRetention, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `metricsRetention` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editsRetention(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editsRetention, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editsRetention` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_eventsRetention(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventsRetention, "min=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventsRetention` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+func (r *Repo) CreateAttachment(ctx context.Context, a entity.Attachment) (entity.Attachment, error) {
+	row, err := r.notesDB.CreateAttachment(ctx, notesrepo.CreateAttachmentParams{
+		NoteID:      converter.ConvertIDToNullID(a.NoteID),
+		StorageKey:  a.StorageKey,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.SHA256,
+		CreatedBy:   a.CreatedBy,
+	})
+	if err != nil {
+		return entity.Attachment{}, fmt.Errorf("create attachment: %v", err)
+	}
+
+	return attachmentToEntity(row), nil
+}
+
+func (r *Repo) GetAttachment(ctx context.Context, noteID, id int64) (entity.Attachment, error) {
+	row, err := r.notesDB.GetAttachment(ctx, notesrepo.GetAttachmentParams{
+		ID:     id,
+		NoteID: converter.ConvertIDToNullID(noteID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Attachment{}, entity.ErrAttachmentNotFound
+		}
+		return entity.Attachment{}, fmt.Errorf("get attachment: %v", err)
+	}
+
+	return attachmentToEntity(row), nil
+}
+
+func (r *Repo) ListAttachments(ctx context.Context, noteID int64) ([]entity.Attachment, error) {
+	rows, err := r.notesDB.ListAttachments(ctx, converter.ConvertIDToNullID(noteID))
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %v", err)
+	}
+
+	return attachmentsToEntity(rows), nil
+}
+
+// ListOrphanedAttachments returns up to limit attachments
+// whose notes were purged.
+func (r *Repo) ListOrphanedAttachments(ctx context.Context, limit int) ([]entity.Attachment, error) {
+	rows, err := r.notesDB.ListOrphanedAttachments(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("list orphaned attachments: %v", err)
+	}
+
+	return attachmentsToEntity(rows), nil
+}
+
+func (r *Repo) DeleteAttachments(ctx context.Context, ids []int64) error {
+	if err := r.notesDB.DeleteAttachments(ctx, ids); err != nil {
+		return fmt.Errorf("delete attachments: %v", err)
+	}
+
+	return nil
+}
+
+func attachmentsToEntity(rows []notesrepo.Attachment) []entity.Attachment {
+	attachments := make([]entity.Attachment, 0, len(rows))
+	for _, row := range rows {
+		attachments = append(attachments, attachmentToEntity(row))
+	}
+
+	return attachments
+}
+
+func attachmentToEntity(row notesrepo.Attachment) entity.Attachment {
+	return entity.Attachment{
+		ID:          row.ID,
+		NoteID:      converter.ConvertNullIDToID(row.NoteID),
+		StorageKey:  row.StorageKey,
+		Filename:    row.Filename,
+		ContentType: row.ContentType,
+		Size:        row.Size,
+		SHA256:      row.Sha256,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   converter.ConvertTimestampzToTime(row.CreatedAt),
+	}
+}
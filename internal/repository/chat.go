@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// ChatMessagesChannel is notified with the id of every created chat message.
+const ChatMessagesChannel = "chat_messages"
+
+// chatMessagesLockKey serializes chat message inserts. It exists only to
+// commit ids in increasing order, the chat relay reads messages after the
+// last seen id and would skip a late committed message. The lock is held
+// until the commit, so messages are inserted last in their transaction.
+const chatMessagesLockKey = 0x63686174
+
+// CreateChatMessage saves the message and notifies ChatMessagesChannel,
+// it must be called in a transaction.
+func (r *Repo) CreateChatMessage(ctx context.Context, m entity.ChatMessage) (entity.ChatMessage, error) {
+	if err := r.notesDB.LockChatMessages(ctx, chatMessagesLockKey); err != nil {
+		return entity.ChatMessage{}, fmt.Errorf("lock chat messages: %v", err)
+	}
+
+	row, err := r.notesDB.CreateChatMessage(ctx, notesrepo.CreateChatMessageParams{
+		NoteID:  m.NoteID,
+		UserID:  m.UserID,
+		Content: m.Content,
+	})
+	if err != nil {
+		return entity.ChatMessage{}, fmt.Errorf("create chat message: %v", err)
+	}
+
+	if err := r.notesDB.NotifyChatMessage(ctx, notesrepo.NotifyChatMessageParams{
+		Channel: ChatMessagesChannel,
+		Payload: strconv.FormatInt(row.ID, 10),
+	}); err != nil {
+		return entity.ChatMessage{}, fmt.Errorf("notify chat message: %v", err)
+	}
+
+	return chatMessageToEntity(row), nil
+}
+
+// ListChatMessages returns messages of the note, newest first.
+func (r *Repo) ListChatMessages(ctx context.Context, q entity.ChatMessagesQuery) ([]entity.ChatMessage, error) {
+	params := notesrepo.ListChatMessagesParams{
+		NoteID:   q.NoteID,
+		PageSize: int32(q.PageSize),
+	}
+	if q.Before > 0 {
+		params.BeforeID = &q.Before
+	}
+
+	rows, err := r.notesDB.ListChatMessages(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list chat messages: %v", err)
+	}
+
+	return chatMessagesToEntity(rows), nil
+}
+
+func (r *Repo) GetLastChatMessageID(ctx context.Context) (int64, error) {
+	id, err := r.notesDB.GetLastChatMessageID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get last chat message id: %v", err)
+	}
+
+	return id, nil
+}
+
+// ListChatMessagesAfter returns messages of every note in id order.
+func (r *Repo) ListChatMessagesAfter(ctx context.Context, after int64, limit int) ([]entity.ChatMessage, error) {
+	rows, err := r.notesDB.ListChatMessagesAfter(ctx, notesrepo.ListChatMessagesAfterParams{
+		AfterID:  after,
+		PageSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list chat messages after: %v", err)
+	}
+
+	return chatMessagesToEntity(rows), nil
+}
+
+func chatMessagesToEntity(rows []notesrepo.ChatMessage) []entity.ChatMessage {
+	messages := make([]entity.ChatMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, chatMessageToEntity(row))
+	}
+
+	return messages
+}
+
+func chatMessageToEntity(row notesrepo.ChatMessage) entity.ChatMessage {
+	return entity.ChatMessage{
+		ID:        row.ID,
+		NoteID:    row.NoteID,
+		UserID:    row.UserID,
+		Content:   row.Content,
+		CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+	}
+}
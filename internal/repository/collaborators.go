@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// GetNoteRole returns the access level of the user to the note. Trashed
+// notes are reported as not found unless includeTrashed is set, only the
+// trash paths look at them. The note owner always gets NoteRoleOwner.
+func (r *Repo) GetNoteRole(ctx context.Context, noteID, userID int64, includeTrashed bool) (entity.NoteRole, error) {
+	role, err := r.notesDB.GetNoteRole(ctx, notesrepo.GetNoteRoleParams{
+		NoteID:         noteID,
+		UserID:         userID,
+		IncludeTrashed: includeTrashed,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NoteRoleNone, entity.ErrNoteNotFound
+		}
+		return entity.NoteRoleNone, fmt.Errorf("get note role: %v", err)
+	}
+
+	return entity.ParseNoteRole(role)
+}
+
+func (r *Repo) UpsertCollaborator(ctx context.Context, c entity.Collaborator) (entity.Collaborator, error) {
+	row, err := r.notesDB.UpsertCollaborator(ctx, notesrepo.UpsertCollaboratorParams{
+		NoteID:         c.NoteID,
+		CollaboratorID: c.UserID,
+		Role:           c.Role.String(),
+	})
+	if err != nil {
+		return entity.Collaborator{}, fmt.Errorf("upsert collaborator: %v", err)
+	}
+
+	return collaboratorToEntity(row)
+}
+
+func (r *Repo) DeleteCollaborator(ctx context.Context, noteID, userID int64) error {
+	affected, err := r.notesDB.DeleteCollaborator(ctx, notesrepo.DeleteCollaboratorParams{
+		NoteID:         noteID,
+		CollaboratorID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete collaborator: %v", err)
+	}
+
+	if affected == 0 {
+		return entity.ErrCollaboratorNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) ListCollaborators(ctx context.Context, noteID int64) ([]entity.Collaborator, error) {
+	rows, err := r.notesDB.ListCollaborators(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("list collaborators: %v", err)
+	}
+
+	collaborators := make([]entity.Collaborator, 0, len(rows))
+	for _, row := range rows {
+		c, err := collaboratorToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, c)
+	}
+
+	return collaborators, nil
+}
+
+func collaboratorToEntity(row notesrepo.NoteCollaborator) (entity.Collaborator, error) {
+	role, err := entity.ParseNoteRole(row.Role)
+	if err != nil {
+		return entity.Collaborator{}, fmt.Errorf("collaborator role %q: %v", row.Role, err)
+	}
+
+	return entity.Collaborator{
+		NoteID:    row.NoteID,
+		UserID:    row.CollaboratorID,
+		Role:      role,
+		CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+	}, nil
+}
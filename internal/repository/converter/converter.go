@@ -14,11 +14,19 @@ import (
 // goverter:output:package generated
 // goverter:extend ConvertTimestampzToTime
 // goverter:extend ConvertTimeToTimestampz
+// goverter:extend ConvertNullIDToID
 // goverter:skipCopySameType
 //go:generate go run github.com/jmattheis/goverter/cmd/goverter@v1.7.0 gen .
 type Converter interface {
+	// goverter:ignore Tags
 	ConvertNoteToEntity(row notesrepo.Note) entity.Note
 	ConvertNotesToEntity(rows []notesrepo.Note) []entity.Note
+
+	ConvertNoteRevisionToEntity(row notesrepo.NoteRevision) entity.NoteRevision
+	ConvertNoteRevisionsToEntity(rows []notesrepo.NoteRevision) []entity.NoteRevision
+
+	ConvertNotebookToEntity(row notesrepo.Notebook) entity.Notebook
+	ConvertNotebooksToEntity(rows []notesrepo.Notebook) []entity.Notebook
 }
 
 func ConvertTimestampzToTime(t pgtype.Timestamptz) time.Time {
@@ -28,3 +36,21 @@ func ConvertTimestampzToTime(t pgtype.Timestamptz) time.Time {
 func ConvertTimeToTimestampz(t time.Time) pgtype.Timestamptz {
 	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
 }
+
+// ConvertNullIDToID maps a nullable reference to 0 when it is not set.
+func ConvertNullIDToID(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+
+	return *id
+}
+
+// ConvertIDToNullID maps 0 to a null reference.
+func ConvertIDToNullID(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+
+	return &id
+}
@@ -14,7 +14,10 @@ func (c *ConverterImpl) ConvertNoteToEntity(row notesrepo.Note) entity.Note {
 	var eNote entity.Note
 	eNote.Content = row.Content
 	eNote.CreatedAt = converter.ConvertTimestampzToTime(row.CreatedAt)
+	eNote.DeletedAt = converter.ConvertTimestampzToTime(row.DeletedAt)
 	eNote.ID = row.ID
+	eNote.NotebookID = converter.ConvertNullIDToID(row.NotebookID)
+	eNote.Revision = row.Revision
 	eNote.Title = row.Title
 	eNote.UpdatedAt = converter.ConvertTimestampzToTime(row.UpdatedAt)
 	eNote.UserID = row.UserID
@@ -30,3 +33,44 @@ func (c *ConverterImpl) ConvertNotesToEntity(rows []notesrepo.Note) []entity.Not
 	}
 	return eNotes
 }
+func (c *ConverterImpl) ConvertNoteRevisionToEntity(row notesrepo.NoteRevision) entity.NoteRevision {
+	var eNoteRevision entity.NoteRevision
+	eNoteRevision.AuthorID = row.AuthorID
+	eNoteRevision.Content = row.Content
+	eNoteRevision.CreatedAt = converter.ConvertTimestampzToTime(row.CreatedAt)
+	eNoteRevision.NoteID = row.NoteID
+	eNoteRevision.Revision = row.Revision
+	eNoteRevision.Title = row.Title
+	return eNoteRevision
+}
+func (c *ConverterImpl) ConvertNoteRevisionsToEntity(rows []notesrepo.NoteRevision) []entity.NoteRevision {
+	var eNoteRevisions []entity.NoteRevision
+	if rows != nil {
+		eNoteRevisions = make([]entity.NoteRevision, len(rows))
+		for i := 0; i < len(rows); i++ {
+			eNoteRevisions[i] = c.ConvertNoteRevisionToEntity(rows[i])
+		}
+	}
+	return eNoteRevisions
+}
+func (c *ConverterImpl) ConvertNotebookToEntity(row notesrepo.Notebook) entity.Notebook {
+	var eNotebook entity.Notebook
+	eNotebook.CreatedAt = converter.ConvertTimestampzToTime(row.CreatedAt)
+	eNotebook.ID = row.ID
+	eNotebook.Name = row.Name
+	eNotebook.ParentID = converter.ConvertNullIDToID(row.ParentID)
+	eNotebook.Path = row.Path
+	eNotebook.UpdatedAt = converter.ConvertTimestampzToTime(row.UpdatedAt)
+	eNotebook.UserID = row.UserID
+	return eNotebook
+}
+func (c *ConverterImpl) ConvertNotebooksToEntity(rows []notesrepo.Notebook) []entity.Notebook {
+	var eNotebooks []entity.Notebook
+	if rows != nil {
+		eNotebooks = make([]entity.Notebook, len(rows))
+		for i := 0; i < len(rows); i++ {
+			eNotebooks[i] = c.ConvertNotebookToEntity(rows[i])
+		}
+	}
+	return eNotebooks
+}
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// NoteEditsChannel is notified with the id of every appended note edit.
+const NoteEditsChannel = "note_edits"
+
+// noteEditsLockKey serializes edit appends. It exists only to commit ids
+// in increasing order, the edit relay reads edits after the last seen id
+// and would skip a late committed edit. The lock is held until the commit,
+// so edits are appended last in their transaction.
+const noteEditsLockKey = 0x65646974
+
+// LockNoteDocument returns the document of the note and locks it until
+// the end of the transaction. A missing document is loaded from the note,
+// notes in the trash have no documents.
+func (r *Repo) LockNoteDocument(ctx context.Context, noteID int64) (entity.NoteDocument, error) {
+	if err := r.notesDB.InitNoteDocument(ctx, noteID); err != nil {
+		return entity.NoteDocument{}, fmt.Errorf("init note document: %v", err)
+	}
+
+	row, err := r.notesDB.LockNoteDocument(ctx, noteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NoteDocument{}, entity.ErrNoteNotFound
+		}
+		return entity.NoteDocument{}, fmt.Errorf("lock note document: %v", err)
+	}
+
+	return entity.NoteDocument{
+		NoteID:          row.NoteID,
+		Content:         row.Content,
+		Version:         row.Version,
+		NoteRevision:    row.NoteRevision,
+		SnapshotVersion: row.SnapshotVersion,
+		LastEditorID:    row.LastEditorID,
+		UpdatedAt:       converter.ConvertTimestampzToTime(row.UpdatedAt),
+	}, nil
+}
+
+func (r *Repo) UpdateNoteDocument(ctx context.Context, d entity.NoteDocument) error {
+	if err := r.notesDB.UpdateNoteDocument(ctx, notesrepo.UpdateNoteDocumentParams{
+		NoteID:          d.NoteID,
+		Content:         d.Content,
+		Version:         d.Version,
+		NoteRevision:    d.NoteRevision,
+		SnapshotVersion: d.SnapshotVersion,
+		LastEditorID:    d.LastEditorID,
+	}); err != nil {
+		return fmt.Errorf("update note document: %v", err)
+	}
+
+	return nil
+}
+
+// ListDirtyNoteDocuments returns notes outside of the trash whose documents
+// have changes not saved to the note, least recently changed first.
+func (r *Repo) ListDirtyNoteDocuments(ctx context.Context, limit int) ([]int64, error) {
+	ids, err := r.notesDB.ListDirtyNoteDocuments(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("list dirty note documents: %v", err)
+	}
+
+	return ids, nil
+}
+
+// AppendNoteEdit adds the edit to the log and notifies NoteEditsChannel,
+// it must be called in a transaction.
+func (r *Repo) AppendNoteEdit(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error) {
+	params := notesrepo.AppendNoteEditParams{
+		NoteID:    e.NoteID,
+		SessionID: pgtype.UUID{Bytes: e.SessionID, Valid: true},
+		UserID:    e.UserID,
+		Kind:      e.Kind.String(),
+		Version:   e.Version,
+	}
+	switch e.Kind {
+	case entity.NoteEditOperation:
+		op, err := json.Marshal(e.Operation)
+		if err != nil {
+			return entity.NoteEdit{}, fmt.Errorf("marshal edit operation: %v", err)
+		}
+		params.Operation = op
+	case entity.NoteEditCursor:
+		position, selectionEnd := int64(e.Cursor.Position), int64(e.Cursor.SelectionEnd)
+		params.Position = &position
+		params.SelectionEnd = &selectionEnd
+	}
+
+	if err := r.notesDB.LockNoteEdits(ctx, noteEditsLockKey); err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("lock note edits: %v", err)
+	}
+
+	row, err := r.notesDB.AppendNoteEdit(ctx, params)
+	if err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("append note edit: %v", err)
+	}
+
+	if err := r.notesDB.NotifyNoteEdit(ctx, notesrepo.NotifyNoteEditParams{
+		Channel: NoteEditsChannel,
+		Payload: strconv.FormatInt(row.ID, 10),
+	}); err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("notify note edit: %v", err)
+	}
+
+	return noteEditToEntity(row)
+}
+
+// ListNoteEditOperations returns operations of the note producing versions
+// after the given one in version order.
+func (r *Repo) ListNoteEditOperations(ctx context.Context, noteID, afterVersion int64) ([]entity.NoteEdit, error) {
+	rows, err := r.notesDB.ListNoteEditOperations(ctx, notesrepo.ListNoteEditOperationsParams{
+		NoteID:       noteID,
+		AfterVersion: afterVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list note edit operations: %v", err)
+	}
+
+	return noteEditsToEntity(rows)
+}
+
+// ListNoteEditCursors returns the last cursor or leave entry of every session
+// of the note logged after the time.
+func (r *Repo) ListNoteEditCursors(ctx context.Context, noteID int64, after time.Time) ([]entity.NoteEdit, error) {
+	rows, err := r.notesDB.ListNoteEditCursors(ctx, notesrepo.ListNoteEditCursorsParams{
+		NoteID:       noteID,
+		CreatedAfter: converter.ConvertTimeToTimestampz(after),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list note edit cursors: %v", err)
+	}
+
+	return noteEditsToEntity(rows)
+}
+
+func (r *Repo) GetLastNoteEditID(ctx context.Context) (int64, error) {
+	id, err := r.notesDB.GetLastNoteEditID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get last note edit id: %v", err)
+	}
+
+	return id, nil
+}
+
+// ListNoteEditsAfter returns edits of every note in id order.
+func (r *Repo) ListNoteEditsAfter(ctx context.Context, after int64, limit int) ([]entity.NoteEdit, error) {
+	rows, err := r.notesDB.ListNoteEditsAfter(ctx, notesrepo.ListNoteEditsAfterParams{
+		AfterID:  after,
+		PageSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list note edits after: %v", err)
+	}
+
+	return noteEditsToEntity(rows)
+}
+
+// PurgeNoteEdits deletes cursor moves and operations saved to the notes
+// logged before the time.
+func (r *Repo) PurgeNoteEdits(ctx context.Context, createdBefore time.Time) (int64, error) {
+	purged, err := r.notesDB.PurgeNoteEdits(ctx, converter.ConvertTimeToTimestampz(createdBefore))
+	if err != nil {
+		return 0, fmt.Errorf("purge note edits: %v", err)
+	}
+
+	return purged, nil
+}
+
+func noteEditsToEntity(rows []notesrepo.NoteEdit) ([]entity.NoteEdit, error) {
+	edits := make([]entity.NoteEdit, 0, len(rows))
+	for _, row := range rows {
+		e, err := noteEditToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, e)
+	}
+
+	return edits, nil
+}
+
+func noteEditToEntity(row notesrepo.NoteEdit) (entity.NoteEdit, error) {
+	kind, err := entity.ParseNoteEditKind(row.Kind)
+	if err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("note edit %d: %w", row.ID, err)
+	}
+
+	e := entity.NoteEdit{
+		ID:        row.ID,
+		NoteID:    row.NoteID,
+		SessionID: row.SessionID.Bytes,
+		UserID:    row.UserID,
+		Kind:      kind,
+		Version:   row.Version,
+		CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+	}
+	if row.Operation != nil {
+		if err := json.Unmarshal(row.Operation, &e.Operation); err != nil {
+			return entity.NoteEdit{}, fmt.Errorf("unmarshal note edit %d: %v", row.ID, err)
+		}
+	}
+	if row.Position != nil && row.SelectionEnd != nil {
+		e.Cursor = entity.EditCursor{
+			Position:     int(*row.Position),
+			SelectionEnd: int(*row.SelectionEnd),
+		}
+	}
+
+	return e, nil
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/database"
+)
+
+// NoteEventsChannel is notified with the sequence of every appended event.
+const NoteEventsChannel = "note_events"
+
+// noteEventsLockKey serializes event appends. The lock exists only to commit
+// sequences in increasing order: the event bus relay, the webhook cursor and
+// resuming subscribers read the log after the last seen sequence and would
+// skip an event committed late with a lower sequence. It is held from the
+// append to the commit, so events are appended last in their transaction.
+const noteEventsLockKey = 0x6e6f7465
+
+// noteEventPayload is a snapshot of the event data stored as jsonb.
+type noteEventPayload struct {
+	Note         entity.Note            `json:"note"`
+	Collaborator entity.Collaborator    `json:"collaborator"`
+	Presence     entity.PresenceSession `json:"presence"`
+}
+
+// AppendNoteEvent adds the event to the log and notifies NoteEventsChannel.
+// It must be called in the transaction of the change, so the log works as
+// a transactional outbox: the event and the notification are committed
+// together with the change or not at all. The event is appended right
+// before the commit, which keeps the log lock short.
+func (r *Repo) AppendNoteEvent(ctx context.Context, event entity.NoteEvent) error {
+	payload, err := json.Marshal(noteEventPayload{
+		Note:         event.Note,
+		Collaborator: event.Collaborator,
+		Presence:     event.Presence,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal note event: %v", err)
+	}
+
+	return database.BeforeCommit(ctx, func(ctx context.Context) error {
+		if err := r.notesDB.LockNoteEvents(ctx, noteEventsLockKey); err != nil {
+			return fmt.Errorf("lock note events: %v", err)
+		}
+
+		row, err := r.notesDB.AppendNoteEvent(ctx, notesrepo.AppendNoteEventParams{
+			Type:       event.Type.String(),
+			NoteID:     event.Note.ID,
+			Recipients: event.Recipients,
+			Payload:    payload,
+		})
+		if err != nil {
+			return fmt.Errorf("append note event: %v", err)
+		}
+
+		if err := r.notesDB.NotifyNoteEvent(ctx, notesrepo.NotifyNoteEventParams{
+			Channel: NoteEventsChannel,
+			Payload: strconv.FormatInt(row.Sequence, 10),
+		}); err != nil {
+			return fmt.Errorf("notify note event: %v", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repo) GetLastNoteEventSequence(ctx context.Context) (int64, error) {
+	sequence, err := r.notesDB.GetLastNoteEventSequence(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get last note event sequence: %v", err)
+	}
+
+	return sequence, nil
+}
+
+// PurgeNoteEvents deletes up to batchSize events logged before the time
+// and returns how many were deleted. Events the webhook dispatcher has not
+// reached yet are kept.
+func (r *Repo) PurgeNoteEvents(ctx context.Context, createdBefore time.Time, batchSize int) (int64, error) {
+	purged, err := r.notesDB.PurgeNoteEvents(ctx, notesrepo.PurgeNoteEventsParams{
+		CreatedBefore: converter.ConvertTimeToTimestampz(createdBefore),
+		BatchSize:     int32(batchSize),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purge note events: %v", err)
+	}
+
+	return purged, nil
+}
+
+// ListNoteEventsAfter returns events of every user in sequence order.
+func (r *Repo) ListNoteEventsAfter(ctx context.Context, after int64, limit int) ([]entity.NoteEvent, error) {
+	rows, err := r.notesDB.ListNoteEventsAfter(ctx, notesrepo.ListNoteEventsAfterParams{
+		AfterSequence: after,
+		PageSize:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list note events after: %v", err)
+	}
+
+	return noteEventsToEntity(rows)
+}
+
+// ListNoteEvents returns events visible to the user in sequence order.
+func (r *Repo) ListNoteEvents(ctx context.Context, q entity.NoteEventsQuery) ([]entity.NoteEvent, error) {
+	rows, err := r.notesDB.ListNoteEvents(ctx, notesrepo.ListNoteEventsParams{
+		AfterSequence: q.AfterSequence,
+		UserID:        q.UserID,
+		PageSize:      int32(q.PageSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list note events: %v", err)
+	}
+
+	return noteEventsToEntity(rows)
+}
+
+func noteEventsToEntity(rows []notesrepo.NoteEvent) ([]entity.NoteEvent, error) {
+	events := make([]entity.NoteEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := noteEventToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func noteEventToEntity(row notesrepo.NoteEvent) (entity.NoteEvent, error) {
+	eventType, err := entity.ParseNoteEventType(row.Type)
+	if err != nil {
+		return entity.NoteEvent{}, fmt.Errorf("note event %d: %w", row.Sequence, err)
+	}
+
+	var payload noteEventPayload
+	if err := json.Unmarshal(row.Payload, &payload); err != nil {
+		return entity.NoteEvent{}, fmt.Errorf("unmarshal note event %d: %v", row.Sequence, err)
+	}
+
+	return entity.NoteEvent{
+		Sequence:     row.Sequence,
+		Type:         eventType,
+		Note:         payload.Note,
+		CreatedAt:    converter.ConvertTimestampzToTime(row.CreatedAt),
+		Collaborator: payload.Collaborator,
+		Presence:     payload.Presence,
+		Recipients:   row.Recipients,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import "github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+
+// Markers SearchNotes wraps matched terms in, they are private use characters,
+// so unlike HTML tags they never mean anything to a client rendering the text.
+const (
+	headlineStartSel = '\uE000'
+	headlineStopSel  = '\uE001'
+)
+
+// splitHeadline strips the markers from a ts_headline result and returns
+// the plain text with the marked ranges.
+func splitHeadline(headline string) (string, []entity.TextRange) {
+	text := make([]rune, 0, len(headline))
+	var highlights []entity.TextRange
+
+	start := -1
+	for _, r := range headline {
+		switch r {
+		case headlineStartSel:
+			start = len(text)
+		case headlineStopSel:
+			if start >= 0 && start < len(text) {
+				highlights = append(highlights, entity.TextRange{Start: start, End: len(text)})
+			}
+			start = -1
+		default:
+			text = append(text, r)
+		}
+	}
+
+	return string(text), highlights
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+func TestSplitHeadline(t *testing.T) {
+	tests := []struct {
+		name       string
+		headline   string
+		text       string
+		highlights []entity.TextRange
+	}{
+		{
+			name:     "no matches",
+			headline: "plain text",
+			text:     "plain text",
+		},
+		{
+			name:       "matches",
+			headline:   "buy \uE000milk\uE001 and \uE000bread\uE001",
+			text:       "buy milk and bread",
+			highlights: []entity.TextRange{{Start: 4, End: 8}, {Start: 13, End: 18}},
+		},
+		{
+			name:       "offsets in runes",
+			headline:   "купить \uE000молоко\uE001",
+			text:       "купить молоко",
+			highlights: []entity.TextRange{{Start: 7, End: 13}},
+		},
+		{
+			name:       "html is kept as text",
+			headline:   "<script>\uE000alert\uE001</script>",
+			text:       "<script>alert</script>",
+			highlights: []entity.TextRange{{Start: 8, End: 13}},
+		},
+		{
+			name:     "unbalanced markers",
+			headline: "a \uE001b\uE000\uE001 c\uE000",
+			text:     "a b c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, highlights := splitHeadline(tt.headline)
+			if text != tt.text {
+				t.Errorf("splitHeadline() text = %q, want %q", text, tt.text)
+			}
+			if !slices.Equal(highlights, tt.highlights) {
+				t.Errorf("splitHeadline() highlights = %v, want %v", highlights, tt.highlights)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// AddNoteViews adds the views to the counters of their hours. Views must
+// be aggregated by note and hour, ViewedAt being the start of the hour.
+func (r *Repo) AddNoteViews(ctx context.Context, views []entity.NoteView) error {
+	params := notesrepo.AddNoteViewsParams{
+		NoteIds: make([]int64, 0, len(views)),
+		Buckets: make([]pgtype.Timestamptz, 0, len(views)),
+		Views:   make([]int64, 0, len(views)),
+	}
+	for _, v := range views {
+		params.NoteIds = append(params.NoteIds, v.NoteID)
+		params.Buckets = append(params.Buckets, converter.ConvertTimeToTimestampz(v.ViewedAt))
+		params.Views = append(params.Views, v.Count)
+	}
+
+	if err := r.notesDB.AddNoteViews(ctx, params); err != nil {
+		return fmt.Errorf("add note views: %v", err)
+	}
+
+	return nil
+}
+
+// LockMetricsBatch serializes uploads of the batch until the end
+// of the transaction.
+func (r *Repo) LockMetricsBatch(ctx context.Context, userID int64, batchID uuid.UUID) error {
+	if err := r.notesDB.LockMetricsBatch(ctx, notesrepo.LockMetricsBatchParams{
+		BatchID: batchID.String(),
+		UserID:  userID,
+	}); err != nil {
+		return fmt.Errorf("lock metrics batch: %v", err)
+	}
+
+	return nil
+}
+
+// ListMetricRecordSequences returns the sequences of the batch records
+// that were already received.
+func (r *Repo) ListMetricRecordSequences(ctx context.Context, userID int64, batchID uuid.UUID, sequences []int64) ([]int64, error) {
+	received, err := r.notesDB.ListMetricRecordSequences(ctx, notesrepo.ListMetricRecordSequencesParams{
+		UserID:    userID,
+		BatchID:   pgtype.UUID{Bytes: batchID, Valid: true},
+		Sequences: sequences,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list metric record sequences: %v", err)
+	}
+
+	return received, nil
+}
+
+// CopyMetricRecords stores the records with COPY, ViewedAt must be
+// the start of the hour.
+func (r *Repo) CopyMetricRecords(ctx context.Context, userID int64, records []entity.MetricRecord) error {
+	rows := make([]notesrepo.CopyMetricRecordsParams, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, notesrepo.CopyMetricRecordsParams{
+			UserID:   userID,
+			BatchID:  pgtype.UUID{Bytes: rec.BatchID, Valid: true},
+			Sequence: rec.Sequence,
+			NoteID:   rec.NoteID,
+			Bucket:   converter.ConvertTimeToTimestampz(rec.ViewedAt),
+			Views:    rec.Count,
+		})
+	}
+
+	if _, err := r.notesDB.CopyMetricRecords(ctx, rows); err != nil {
+		return fmt.Errorf("copy metric records: %v", err)
+	}
+
+	return nil
+}
+
+// PurgeMetricRecords deletes records received before the time, uploads
+// retried later are not deduplicated anymore.
+func (r *Repo) PurgeMetricRecords(ctx context.Context, receivedBefore time.Time) (int64, error) {
+	purged, err := r.notesDB.PurgeMetricRecords(ctx, converter.ConvertTimeToTimestampz(receivedBefore))
+	if err != nil {
+		return 0, fmt.Errorf("purge metric records: %v", err)
+	}
+
+	return purged, nil
+}
+
+// GetNoteViewStats returns a bucket of the given length for every step
+// from the first to the last bucket inclusive.
+func (r *Repo) GetNoteViewStats(
+	ctx context.Context,
+	noteID int64,
+	first, last time.Time,
+	step time.Duration,
+) ([]entity.ViewBucket, error) {
+	rows, err := r.notesDB.GetNoteViewStats(ctx, notesrepo.GetNoteViewStatsParams{
+		FromBucket:  converter.ConvertTimeToTimestampz(first),
+		LastBucket:  converter.ConvertTimeToTimestampz(last),
+		StepSeconds: int64(step / time.Second),
+		NoteID:      noteID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get note view stats: %v", err)
+	}
+
+	buckets := make([]entity.ViewBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, entity.ViewBucket{
+			Start: converter.ConvertTimestampzToTime(row.Bucket).UTC(),
+			Views: row.Views,
+		})
+	}
+
+	return buckets, nil
+}
+
+func (r *Repo) ListTopNotes(ctx context.Context, q entity.TopNotesQuery) ([]entity.NoteViews, error) {
+	rows, err := r.notesDB.ListTopNotes(ctx, notesrepo.ListTopNotesParams{
+		FromBucket: converter.ConvertTimeToTimestampz(q.From),
+		ToBucket:   converter.ConvertTimeToTimestampz(q.To),
+		UserID:     q.UserID,
+		PageSize:   int32(q.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list top notes: %v", err)
+	}
+
+	notes := make([]entity.Note, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, entity.Note{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Title:     row.Title,
+			Content:   row.Content,
+			CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+			UpdatedAt: converter.ConvertTimestampzToTime(row.UpdatedAt),
+			Revision:  row.Revision,
+
+			NotebookID: converter.ConvertNullIDToID(row.NotebookID),
+		})
+	}
+	if err := r.attachTags(ctx, notes); err != nil {
+		return nil, err
+	}
+
+	top := make([]entity.NoteViews, 0, len(rows))
+	for i, row := range rows {
+		top = append(top, entity.NoteViews{
+			Note:  notes[i],
+			Views: row.Views,
+		})
+	}
+
+	return top, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// CreateNotebook creates a notebook under the parent, parent is nil for
+// a root notebook.
+func (r *Repo) CreateNotebook(ctx context.Context, userID int64, name string, parent *entity.Notebook) (entity.Notebook, error) {
+	params := notesrepo.CreateNotebookParams{
+		UserID:     userID,
+		Name:       name,
+		ParentPath: "/",
+	}
+	if parent != nil {
+		params.ParentID = &parent.ID
+		params.ParentPath = parent.Path
+	}
+
+	row, err := r.notesDB.CreateNotebook(ctx, params)
+	if err != nil {
+		return entity.Notebook{}, fmt.Errorf("create notebook: %v", err)
+	}
+
+	return conv.ConvertNotebookToEntity(row), nil
+}
+
+func (r *Repo) GetNotebook(ctx context.Context, userID, id int64) (entity.Notebook, error) {
+	row, err := r.notesDB.GetNotebook(ctx, notesrepo.GetNotebookParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Notebook{}, entity.ErrNotebookNotFound
+		}
+		return entity.Notebook{}, fmt.Errorf("get notebook: %v", err)
+	}
+
+	return conv.ConvertNotebookToEntity(row), nil
+}
+
+// ListChildNotebooks returns direct children of the notebook, parentID 0
+// lists root notebooks.
+func (r *Repo) ListChildNotebooks(ctx context.Context, userID, parentID int64) ([]entity.Notebook, error) {
+	rows, err := r.notesDB.ListChildNotebooks(ctx, notesrepo.ListChildNotebooksParams{
+		UserID:   userID,
+		ParentID: converter.ConvertIDToNullID(parentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list child notebooks: %v", err)
+	}
+
+	return conv.ConvertNotebooksToEntity(rows), nil
+}
+
+// ListNotebookSubtree returns notebooks whose path starts with pathPrefix
+// ordered by path. Children of the returned nodes are not filled.
+func (r *Repo) ListNotebookSubtree(ctx context.Context, userID int64, pathPrefix string) ([]entity.NotebookTree, error) {
+	rows, err := r.notesDB.ListNotebookSubtree(ctx, notesrepo.ListNotebookSubtreeParams{
+		UserID:     userID,
+		PathPrefix: pathPrefix,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list notebook subtree: %v", err)
+	}
+
+	nodes := make([]entity.NotebookTree, 0, len(rows))
+	for _, row := range rows {
+		nodes = append(nodes, entity.NotebookTree{
+			Notebook: entity.Notebook{
+				ID:        row.ID,
+				UserID:    row.UserID,
+				ParentID:  converter.ConvertNullIDToID(row.ParentID),
+				Name:      row.Name,
+				Path:      row.Path,
+				CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+				UpdatedAt: converter.ConvertTimestampzToTime(row.UpdatedAt),
+			},
+			NoteCount: row.NoteCount,
+		})
+	}
+
+	return nodes, nil
+}
+
+func (r *Repo) RenameNotebook(ctx context.Context, userID, id int64, name string) (entity.Notebook, error) {
+	row, err := r.notesDB.RenameNotebook(ctx, notesrepo.RenameNotebookParams{
+		ID:     id,
+		UserID: userID,
+		Name:   name,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Notebook{}, entity.ErrNotebookNotFound
+		}
+		return entity.Notebook{}, fmt.Errorf("rename notebook: %v", err)
+	}
+
+	return conv.ConvertNotebookToEntity(row), nil
+}
+
+// MoveNotebook reparents the notebook and rewrites paths of its subtree.
+// It should run in a transaction.
+func (r *Repo) MoveNotebook(ctx context.Context, nb entity.Notebook, parent *entity.Notebook) (entity.Notebook, error) {
+	params := notesrepo.MoveNotebookParams{
+		ID:     nb.ID,
+		UserID: nb.UserID,
+	}
+	newPath := fmt.Sprintf("/%d/", nb.ID)
+	if parent != nil {
+		params.ParentID = &parent.ID
+		newPath = fmt.Sprintf("%s%d/", parent.Path, nb.ID)
+	}
+
+	row, err := r.notesDB.MoveNotebook(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Notebook{}, entity.ErrNotebookNotFound
+		}
+		return entity.Notebook{}, fmt.Errorf("move notebook: %v", err)
+	}
+
+	if _, err := r.notesDB.RewriteNotebookPaths(ctx, notesrepo.RewriteNotebookPathsParams{
+		UserID:    nb.UserID,
+		OldPrefix: nb.Path,
+		NewPrefix: newPath,
+	}); err != nil {
+		return entity.Notebook{}, fmt.Errorf("rewrite notebook paths: %v", err)
+	}
+
+	moved := conv.ConvertNotebookToEntity(row)
+	moved.Path = newPath
+
+	return moved, nil
+}
+
+// DeleteNotebook removes the notebook with its subtree, notes of removed
+// notebooks stay outside of any notebook.
+func (r *Repo) DeleteNotebook(ctx context.Context, userID, id int64) error {
+	affected, err := r.notesDB.DeleteNotebook(ctx, notesrepo.DeleteNotebookParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete notebook: %v", err)
+	}
+
+	if affected == 0 {
+		return entity.ErrNotebookNotFound
+	}
+
+	return nil
+}
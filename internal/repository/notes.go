@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
 	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
 )
@@ -36,22 +39,292 @@ func (r *Repo) GetNote(ctx context.Context, id int64) (entity.Note, error) {
 		return entity.Note{}, fmt.Errorf("get note: %v", err)
 	}
 
-	return conv.ConvertNoteToEntity(row), nil
+	return r.noteWithTags(ctx, conv.ConvertNoteToEntity(row))
 }
 
-func (r *Repo) GetNotesByUserID(ctx context.Context, userID int64) ([]entity.Note, error) {
-	rows, err := r.notesDB.GetNotesByUserID(ctx, userID)
+// ListNotes returns at most q.PageSize notes following q.After in the
+// requested order.
+func (r *Repo) ListNotes(ctx context.Context, q entity.NotesQuery) ([]entity.Note, error) {
+	var (
+		afterID        *int64
+		afterTitle     *string
+		afterCreatedAt pgtype.Timestamptz
+		afterUpdatedAt pgtype.Timestamptz
+	)
+	if q.After != nil {
+		afterID = &q.After.ID
+		afterTitle = &q.After.Title
+		afterCreatedAt = converter.ConvertTimeToTimestampz(q.After.CreatedAt)
+		afterUpdatedAt = converter.ConvertTimeToTimestampz(q.After.UpdatedAt)
+	}
+
+	createdFrom := converter.ConvertTimeToTimestampz(q.CreatedFrom)
+	createdTo := converter.ConvertTimeToTimestampz(q.CreatedTo)
+	updatedFrom := converter.ConvertTimeToTimestampz(q.UpdatedFrom)
+	updatedTo := converter.ConvertTimeToTimestampz(q.UpdatedTo)
+	pageSize := int32(q.PageSize)
+
+	var tagIDs []int64
+	if len(q.Tags) > 0 {
+		ids, err := r.tagIDs(ctx, q.UserID, q.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("list notes: %v", err)
+		}
+
+		// an unknown tag matches no notes
+		if len(ids) == 0 || (q.MatchAllTags && len(ids) < len(q.Tags)) {
+			return []entity.Note{}, nil
+		}
+		tagIDs = ids
+	}
+
+	var (
+		rows []notesrepo.Note
+		err  error
+	)
+
+	switch q.OrderBy {
+	case entity.NoteOrderByUpdatedAt:
+		params := notesrepo.ListNotesByUpdatedAtDescParams{
+			UserID:         q.UserID,
+			CreatedFrom:    createdFrom,
+			CreatedTo:      createdTo,
+			UpdatedFrom:    updatedFrom,
+			UpdatedTo:      updatedTo,
+			TagIds:         tagIDs,
+			MatchAllTags:   q.MatchAllTags,
+			AfterID:        afterID,
+			AfterUpdatedAt: afterUpdatedAt,
+			PageSize:       pageSize,
+		}
+		if q.Descending {
+			rows, err = r.notesDB.ListNotesByUpdatedAtDesc(ctx, params)
+		} else {
+			rows, err = r.notesDB.ListNotesByUpdatedAtAsc(ctx, notesrepo.ListNotesByUpdatedAtAscParams(params))
+		}
+
+	case entity.NoteOrderByTitle:
+		params := notesrepo.ListNotesByTitleDescParams{
+			UserID:       q.UserID,
+			CreatedFrom:  createdFrom,
+			CreatedTo:    createdTo,
+			UpdatedFrom:  updatedFrom,
+			UpdatedTo:    updatedTo,
+			TagIds:       tagIDs,
+			MatchAllTags: q.MatchAllTags,
+			AfterID:      afterID,
+			AfterTitle:   afterTitle,
+			PageSize:     pageSize,
+		}
+		if q.Descending {
+			rows, err = r.notesDB.ListNotesByTitleDesc(ctx, params)
+		} else {
+			rows, err = r.notesDB.ListNotesByTitleAsc(ctx, notesrepo.ListNotesByTitleAscParams(params))
+		}
+
+	default:
+		params := notesrepo.ListNotesByCreatedAtDescParams{
+			UserID:         q.UserID,
+			CreatedFrom:    createdFrom,
+			CreatedTo:      createdTo,
+			UpdatedFrom:    updatedFrom,
+			UpdatedTo:      updatedTo,
+			TagIds:         tagIDs,
+			MatchAllTags:   q.MatchAllTags,
+			AfterID:        afterID,
+			AfterCreatedAt: afterCreatedAt,
+			PageSize:       pageSize,
+		}
+		if q.Descending {
+			rows, err = r.notesDB.ListNotesByCreatedAtDesc(ctx, params)
+		} else {
+			rows, err = r.notesDB.ListNotesByCreatedAtAsc(ctx, notesrepo.ListNotesByCreatedAtAscParams(params))
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("get notes by user: %v", err)
+		return nil, fmt.Errorf("list notes: %v", err)
+	}
+
+	notes := conv.ConvertNotesToEntity(rows)
+	if err := r.attachTags(ctx, notes); err != nil {
+		return nil, err
 	}
 
-	return conv.ConvertNotesToEntity(rows), nil
+	return notes, nil
 }
 
-func (r *Repo) DeleteNote(ctx context.Context, id int64) error {
-	if err := r.notesDB.DeleteNote(ctx, id); err != nil {
-		return fmt.Errorf("delete note: %v", err)
+func (r *Repo) UpdateNote(ctx context.Context, id int64, upd entity.NoteUpdate) (entity.Note, error) {
+	row, err := r.notesDB.UpdateNote(ctx, notesrepo.UpdateNoteParams{
+		ID:       id,
+		Revision: upd.Revision,
+		Title:    upd.Title,
+		Content:  upd.Content,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Note{}, r.staleWriteError(ctx, id)
+		}
+		return entity.Note{}, fmt.Errorf("update note: %v", err)
+	}
+
+	return r.noteWithTags(ctx, conv.ConvertNoteToEntity(row))
+}
+
+func (r *Repo) DeleteNote(ctx context.Context, id, revision int64) (entity.Note, error) {
+	row, err := r.notesDB.DeleteNote(ctx, notesrepo.DeleteNoteParams{
+		ID:       id,
+		Revision: revision,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Note{}, r.staleWriteError(ctx, id)
+		}
+		return entity.Note{}, fmt.Errorf("delete note: %v", err)
+	}
+
+	return r.noteWithTags(ctx, conv.ConvertNoteToEntity(row))
+}
+
+// staleWriteError tells apart a missing note from a note whose revision
+// moved on since the caller read it.
+func (r *Repo) staleWriteError(ctx context.Context, id int64) error {
+	if _, err := r.notesDB.GetNote(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNoteNotFound
+		}
+		return fmt.Errorf("check note revision: %v", err)
+	}
+
+	return entity.ErrNoteRevisionMismatch
+}
+
+func (r *Repo) SearchNotes(ctx context.Context, q entity.SearchQuery) ([]entity.SearchResult, error) {
+	params := notesrepo.SearchNotesParams{
+		UserID:   q.UserID,
+		Query:    q.Query,
+		Language: string(q.Language),
+		PageSize: int32(q.PageSize),
+	}
+	if q.After != nil {
+		params.AfterID = &q.After.ID
+		params.AfterRank = &q.After.Rank
+	}
+
+	rows, err := r.notesDB.SearchNotes(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("search notes: %v", err)
+	}
+
+	results := make([]entity.SearchResult, 0, len(rows))
+	for _, row := range rows {
+		snippet, highlights := splitHeadline(row.Snippet)
+		results = append(results, entity.SearchResult{
+			Note: entity.Note{
+				ID:        row.ID,
+				UserID:    row.UserID,
+				Title:     row.Title,
+				Content:   row.Content,
+				CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+				UpdatedAt: converter.ConvertTimestampzToTime(row.UpdatedAt),
+				Revision:  row.Revision,
+
+				NotebookID: converter.ConvertNullIDToID(row.NotebookID),
+			},
+			Rank:       row.Rank,
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+
+	notes := make([]entity.Note, 0, len(results))
+	for _, res := range results {
+		notes = append(notes, res.Note)
+	}
+	if err := r.attachTags(ctx, notes); err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Note.Tags = notes[i].Tags
+	}
+
+	return results, nil
+}
+
+func (r *Repo) ListTrashedNotes(ctx context.Context, q entity.NotesQuery) ([]entity.Note, error) {
+	params := notesrepo.ListTrashedNotesParams{
+		UserID:   q.UserID,
+		PageSize: int32(q.PageSize),
+	}
+	if q.After != nil {
+		params.AfterID = &q.After.ID
+		params.AfterDeletedAt = converter.ConvertTimeToTimestampz(q.After.DeletedAt)
+	}
+
+	rows, err := r.notesDB.ListTrashedNotes(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list trashed notes: %v", err)
+	}
+
+	notes := conv.ConvertNotesToEntity(rows)
+	if err := r.attachTags(ctx, notes); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func (r *Repo) RestoreNote(ctx context.Context, id int64) (entity.Note, error) {
+	row, err := r.notesDB.RestoreNote(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Note{}, entity.ErrNoteNotFound
+		}
+		return entity.Note{}, fmt.Errorf("restore note: %v", err)
+	}
+
+	return r.noteWithTags(ctx, conv.ConvertNoteToEntity(row))
+}
+
+func (r *Repo) PurgeNote(ctx context.Context, id int64) error {
+	affected, err := r.notesDB.PurgeNote(ctx, id)
+	if err != nil {
+		return fmt.Errorf("purge note: %v", err)
+	}
+
+	if affected == 0 {
+		return entity.ErrNoteNotFound
 	}
 
 	return nil
 }
+
+// PurgeDeletedNotes permanently removes up to batchSize notes trashed
+// before deletedBefore and returns how many were removed.
+func (r *Repo) PurgeDeletedNotes(ctx context.Context, deletedBefore time.Time, batchSize int) (int64, error) {
+	affected, err := r.notesDB.PurgeDeletedNotes(ctx, notesrepo.PurgeDeletedNotesParams{
+		DeletedBefore: converter.ConvertTimeToTimestampz(deletedBefore),
+		BatchSize:     int32(batchSize),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purge deleted notes: %v", err)
+	}
+
+	return affected, nil
+}
+
+// MoveNote puts the note into the notebook, 0 takes it out of any notebook.
+func (r *Repo) MoveNote(ctx context.Context, id, notebookID, revision int64) (entity.Note, error) {
+	row, err := r.notesDB.MoveNote(ctx, notesrepo.MoveNoteParams{
+		ID:         id,
+		NotebookID: converter.ConvertIDToNullID(notebookID),
+		Revision:   revision,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Note{}, r.staleWriteError(ctx, id)
+		}
+		return entity.Note{}, fmt.Errorf("move note: %v", err)
+	}
+
+	return r.noteWithTags(ctx, conv.ConvertNoteToEntity(row))
+}
@@ -0,0 +1,27 @@
+-- name: CreateAttachment :one
+INSERT INTO attachments (note_id, storage_key, filename, content_type, size, sha256, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at;
+
+-- name: GetAttachment :one
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE id = $1
+  AND note_id = $2;
+
+-- name: ListAttachments :many
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE note_id = $1
+ORDER BY id;
+
+-- name: ListOrphanedAttachments :many
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE note_id IS NULL
+ORDER BY id
+LIMIT $1;
+
+-- name: DeleteAttachments :exec
+DELETE FROM attachments
+WHERE id = ANY(sqlc.arg('ids')::bigint[]);
@@ -0,0 +1,30 @@
+-- name: LockChatMessages :exec
+-- Held until the commit so ids commit in order for the chat relay.
+SELECT pg_advisory_xact_lock($1);
+
+-- name: CreateChatMessage :one
+INSERT INTO chat_messages (note_id, user_id, content)
+VALUES ($1, $2, $3)
+RETURNING id, note_id, user_id, content, created_at;
+
+-- name: NotifyChatMessage :exec
+SELECT pg_notify(sqlc.arg('channel')::text, sqlc.arg('payload')::text);
+
+-- name: ListChatMessages :many
+SELECT id, note_id, user_id, content, created_at
+FROM chat_messages
+WHERE note_id = sqlc.arg('note_id')
+  AND (sqlc.narg('before_id')::bigint IS NULL OR id < sqlc.narg('before_id'))
+ORDER BY id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: GetLastChatMessageID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_id
+FROM chat_messages;
+
+-- name: ListChatMessagesAfter :many
+SELECT id, note_id, user_id, content, created_at
+FROM chat_messages
+WHERE id > sqlc.arg('after_id')
+ORDER BY id
+LIMIT sqlc.arg('page_size');
@@ -0,0 +1,27 @@
+-- name: GetNoteRole :one
+SELECT (CASE
+            WHEN n.user_id = sqlc.arg('user_id') THEN 'owner'
+            ELSE coalesce(c.role, '')
+    END)::text AS role
+FROM notes n
+         LEFT JOIN note_collaborators c ON c.note_id = n.id AND c.collaborator_id = sqlc.arg('user_id')
+WHERE n.id = sqlc.arg('note_id')
+  AND (n.deleted_at IS NULL OR sqlc.arg('include_trashed')::bool);
+
+-- name: UpsertCollaborator :one
+INSERT INTO note_collaborators (note_id, collaborator_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (note_id, collaborator_id) DO UPDATE SET role = excluded.role
+RETURNING note_id, collaborator_id, role, created_at;
+
+-- name: DeleteCollaborator :execrows
+DELETE
+FROM note_collaborators
+WHERE note_id = $1
+  AND collaborator_id = $2;
+
+-- name: ListCollaborators :many
+SELECT note_id, collaborator_id, role, created_at
+FROM note_collaborators
+WHERE note_id = $1
+ORDER BY created_at, collaborator_id;
@@ -0,0 +1,78 @@
+-- name: InitNoteDocument :exec
+INSERT INTO note_documents (note_id, content, version, note_revision, snapshot_version, last_editor_id)
+SELECT id, content, 0, revision, 0, user_id
+FROM notes
+WHERE id = $1
+  AND deleted_at IS NULL
+ON CONFLICT (note_id) DO NOTHING;
+
+-- name: LockNoteDocument :one
+SELECT note_id, content, version, note_revision, snapshot_version, last_editor_id, updated_at
+FROM note_documents
+WHERE note_id = $1
+FOR UPDATE;
+
+-- name: UpdateNoteDocument :exec
+UPDATE note_documents
+SET content          = $2,
+    version          = $3,
+    note_revision    = $4,
+    snapshot_version = $5,
+    last_editor_id   = $6,
+    updated_at       = now()
+WHERE note_id = $1;
+
+-- name: ListDirtyNoteDocuments :many
+SELECT d.note_id
+FROM note_documents d
+         JOIN notes n ON n.id = d.note_id
+WHERE d.version > d.snapshot_version
+  AND n.deleted_at IS NULL
+ORDER BY d.updated_at
+LIMIT $1;
+
+-- name: LockNoteEdits :exec
+-- Held until the commit so ids commit in order for the edit relay.
+SELECT pg_advisory_xact_lock($1);
+
+-- name: AppendNoteEdit :one
+INSERT INTO note_edits (note_id, session_id, user_id, kind, version, operation, position, selection_end)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at;
+
+-- name: NotifyNoteEdit :exec
+SELECT pg_notify(sqlc.arg('channel')::text, sqlc.arg('payload')::text);
+
+-- name: ListNoteEditOperations :many
+SELECT id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE note_id = sqlc.arg('note_id')
+  AND kind = 'operation'
+  AND version > sqlc.arg('after_version')
+ORDER BY version;
+
+-- name: ListNoteEditCursors :many
+SELECT DISTINCT ON (session_id) id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE note_id = sqlc.arg('note_id')
+  AND kind <> 'operation'
+  AND created_at > sqlc.arg('created_after')
+ORDER BY session_id, id DESC;
+
+-- name: GetLastNoteEditID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_id
+FROM note_edits;
+
+-- name: ListNoteEditsAfter :many
+SELECT id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE id > sqlc.arg('after_id')
+ORDER BY id
+LIMIT sqlc.arg('page_size');
+
+-- name: PurgeNoteEdits :execrows
+DELETE FROM note_edits e
+USING note_documents d
+WHERE e.note_id = d.note_id
+  AND e.created_at < sqlc.arg('created_before')
+  AND (e.kind <> 'operation' OR e.version <= d.snapshot_version);
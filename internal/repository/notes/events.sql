@@ -0,0 +1,42 @@
+-- name: LockNoteEvents :exec
+-- Held until the commit so sequences commit in order for the log readers.
+SELECT pg_advisory_xact_lock($1);
+
+-- name: AppendNoteEvent :one
+INSERT INTO note_events (type, note_id, recipients, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING sequence, type, note_id, recipients, payload, created_at;
+
+-- name: ListNoteEvents :many
+SELECT sequence, type, note_id, recipients, payload, created_at
+FROM note_events
+WHERE sequence > sqlc.arg('after_sequence')
+  AND recipients @> ARRAY[sqlc.arg('user_id')::bigint]
+ORDER BY sequence
+LIMIT sqlc.arg('page_size');
+
+-- name: NotifyNoteEvent :exec
+SELECT pg_notify(sqlc.arg('channel')::text, sqlc.arg('payload')::text);
+
+-- name: GetLastNoteEventSequence :one
+SELECT COALESCE(MAX(sequence), 0)::bigint AS last_sequence
+FROM note_events;
+
+-- name: PurgeNoteEvents :execrows
+-- Events the webhook dispatcher has not reached yet are kept.
+DELETE FROM note_events
+WHERE sequence IN (
+    SELECT expired.sequence
+    FROM note_events expired
+    WHERE expired.created_at < sqlc.arg('created_before')
+      AND expired.sequence <= (SELECT coalesce(max(sequence), 0) FROM webhook_cursor)
+    ORDER BY expired.sequence
+    LIMIT sqlc.arg('batch_size')
+);
+
+-- name: ListNoteEventsAfter :many
+SELECT sequence, type, note_id, recipients, payload, created_at
+FROM note_events
+WHERE sequence > sqlc.arg('after_sequence')
+ORDER BY sequence
+LIMIT sqlc.arg('page_size');
@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: attachments.sql
+
+package notesrepo
+
+import (
+	"context"
+)
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachments (note_id, storage_key, filename, content_type, size, sha256, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+`
+
+type CreateAttachmentParams struct {
+	NoteID      *int64
+	StorageKey  string
+	Filename    string
+	ContentType string
+	Size        int64
+	Sha256      []byte
+	CreatedBy   int64
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		arg.NoteID,
+		arg.StorageKey,
+		arg.Filename,
+		arg.ContentType,
+		arg.Size,
+		arg.Sha256,
+		arg.CreatedBy,
+	)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.StorageKey,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAttachments = `-- name: DeleteAttachments :exec
+DELETE FROM attachments
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) DeleteAttachments(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, deleteAttachments, ids)
+	return err
+}
+
+const getAttachment = `-- name: GetAttachment :one
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE id = $1
+  AND note_id = $2
+`
+
+type GetAttachmentParams struct {
+	ID     int64
+	NoteID *int64
+}
+
+func (q *Queries) GetAttachment(ctx context.Context, arg GetAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachment, arg.ID, arg.NoteID)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.StorageKey,
+		&i.Filename,
+		&i.ContentType,
+		&i.Size,
+		&i.Sha256,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAttachments = `-- name: ListAttachments :many
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE note_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAttachments(ctx context.Context, noteID *int64) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, listAttachments, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.StorageKey,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Sha256,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrphanedAttachments = `-- name: ListOrphanedAttachments :many
+SELECT id, note_id, storage_key, filename, content_type, size, sha256, created_by, created_at
+FROM attachments
+WHERE note_id IS NULL
+ORDER BY id
+LIMIT $1
+`
+
+func (q *Queries) ListOrphanedAttachments(ctx context.Context, limit int32) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, listOrphanedAttachments, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.StorageKey,
+			&i.Filename,
+			&i.ContentType,
+			&i.Size,
+			&i.Sha256,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,151 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: chat.sql
+
+package notesrepo
+
+import (
+	"context"
+)
+
+const createChatMessage = `-- name: CreateChatMessage :one
+INSERT INTO chat_messages (note_id, user_id, content)
+VALUES ($1, $2, $3)
+RETURNING id, note_id, user_id, content, created_at
+`
+
+type CreateChatMessageParams struct {
+	NoteID  int64
+	UserID  int64
+	Content string
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRow(ctx, createChatMessage, arg.NoteID, arg.UserID, arg.Content)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.UserID,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastChatMessageID = `-- name: GetLastChatMessageID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_id
+FROM chat_messages
+`
+
+func (q *Queries) GetLastChatMessageID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastChatMessageID)
+	var last_id int64
+	err := row.Scan(&last_id)
+	return last_id, err
+}
+
+const listChatMessages = `-- name: ListChatMessages :many
+SELECT id, note_id, user_id, content, created_at
+FROM chat_messages
+WHERE note_id = $1
+  AND ($2::bigint IS NULL OR id < $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListChatMessagesParams struct {
+	NoteID   int64
+	BeforeID *int64
+	PageSize int32
+}
+
+func (q *Queries) ListChatMessages(ctx context.Context, arg ListChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.Query(ctx, listChatMessages, arg.NoteID, arg.BeforeID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ChatMessage{}
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.UserID,
+			&i.Content,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChatMessagesAfter = `-- name: ListChatMessagesAfter :many
+SELECT id, note_id, user_id, content, created_at
+FROM chat_messages
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListChatMessagesAfterParams struct {
+	AfterID  int64
+	PageSize int32
+}
+
+func (q *Queries) ListChatMessagesAfter(ctx context.Context, arg ListChatMessagesAfterParams) ([]ChatMessage, error) {
+	rows, err := q.db.Query(ctx, listChatMessagesAfter, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ChatMessage{}
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.UserID,
+			&i.Content,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockChatMessages = `-- name: LockChatMessages :exec
+SELECT pg_advisory_xact_lock($1)
+`
+
+// Held until the commit so ids commit in order for the chat relay.
+func (q *Queries) LockChatMessages(ctx context.Context, pgAdvisoryXactLock int64) error {
+	_, err := q.db.Exec(ctx, lockChatMessages, pgAdvisoryXactLock)
+	return err
+}
+
+const notifyChatMessage = `-- name: NotifyChatMessage :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyChatMessageParams struct {
+	Channel string
+	Payload string
+}
+
+func (q *Queries) NotifyChatMessage(ctx context.Context, arg NotifyChatMessageParams) error {
+	_, err := q.db.Exec(ctx, notifyChatMessage, arg.Channel, arg.Payload)
+	return err
+}
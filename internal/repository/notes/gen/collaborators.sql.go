@@ -0,0 +1,111 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: collaborators.sql
+
+package notesrepo
+
+import (
+	"context"
+)
+
+const deleteCollaborator = `-- name: DeleteCollaborator :execrows
+DELETE
+FROM note_collaborators
+WHERE note_id = $1
+  AND collaborator_id = $2
+`
+
+type DeleteCollaboratorParams struct {
+	NoteID         int64
+	CollaboratorID int64
+}
+
+func (q *Queries) DeleteCollaborator(ctx context.Context, arg DeleteCollaboratorParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCollaborator, arg.NoteID, arg.CollaboratorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getNoteRole = `-- name: GetNoteRole :one
+SELECT (CASE
+            WHEN n.user_id = $1 THEN 'owner'
+            ELSE coalesce(c.role, '')
+    END)::text AS role
+FROM notes n
+         LEFT JOIN note_collaborators c ON c.note_id = n.id AND c.collaborator_id = $1
+WHERE n.id = $2
+  AND (n.deleted_at IS NULL OR $3::bool)
+`
+
+type GetNoteRoleParams struct {
+	UserID         int64
+	NoteID         int64
+	IncludeTrashed bool
+}
+
+func (q *Queries) GetNoteRole(ctx context.Context, arg GetNoteRoleParams) (string, error) {
+	row := q.db.QueryRow(ctx, getNoteRole, arg.UserID, arg.NoteID, arg.IncludeTrashed)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const listCollaborators = `-- name: ListCollaborators :many
+SELECT note_id, collaborator_id, role, created_at
+FROM note_collaborators
+WHERE note_id = $1
+ORDER BY created_at, collaborator_id
+`
+
+func (q *Queries) ListCollaborators(ctx context.Context, noteID int64) ([]NoteCollaborator, error) {
+	rows, err := q.db.Query(ctx, listCollaborators, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteCollaborator{}
+	for rows.Next() {
+		var i NoteCollaborator
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.CollaboratorID,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertCollaborator = `-- name: UpsertCollaborator :one
+INSERT INTO note_collaborators (note_id, collaborator_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (note_id, collaborator_id) DO UPDATE SET role = excluded.role
+RETURNING note_id, collaborator_id, role, created_at
+`
+
+type UpsertCollaboratorParams struct {
+	NoteID         int64
+	CollaboratorID int64
+	Role           string
+}
+
+func (q *Queries) UpsertCollaborator(ctx context.Context, arg UpsertCollaboratorParams) (NoteCollaborator, error) {
+	row := q.db.QueryRow(ctx, upsertCollaborator, arg.NoteID, arg.CollaboratorID, arg.Role)
+	var i NoteCollaborator
+	err := row.Scan(
+		&i.NoteID,
+		&i.CollaboratorID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: copyfrom.go
+
+package notesrepo
+
+import (
+	"context"
+)
+
+// iteratorForCopyMetricRecords implements pgx.CopyFromSource.
+type iteratorForCopyMetricRecords struct {
+	rows                 []CopyMetricRecordsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyMetricRecords) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyMetricRecords) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].UserID,
+		r.rows[0].BatchID,
+		r.rows[0].Sequence,
+		r.rows[0].NoteID,
+		r.rows[0].Bucket,
+		r.rows[0].Views,
+	}, nil
+}
+
+func (r iteratorForCopyMetricRecords) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyMetricRecords(ctx context.Context, arg []CopyMetricRecordsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"metric_records"}, []string{"user_id", "batch_id", "sequence", "note_id", "bucket", "views"}, &iteratorForCopyMetricRecords{rows: arg})
+}
@@ -0,0 +1,340 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: edits.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const appendNoteEdit = `-- name: AppendNoteEdit :one
+INSERT INTO note_edits (note_id, session_id, user_id, kind, version, operation, position, selection_end)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+`
+
+type AppendNoteEditParams struct {
+	NoteID       int64
+	SessionID    pgtype.UUID
+	UserID       int64
+	Kind         string
+	Version      int64
+	Operation    []byte
+	Position     *int64
+	SelectionEnd *int64
+}
+
+func (q *Queries) AppendNoteEdit(ctx context.Context, arg AppendNoteEditParams) (NoteEdit, error) {
+	row := q.db.QueryRow(ctx, appendNoteEdit,
+		arg.NoteID,
+		arg.SessionID,
+		arg.UserID,
+		arg.Kind,
+		arg.Version,
+		arg.Operation,
+		arg.Position,
+		arg.SelectionEnd,
+	)
+	var i NoteEdit
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.SessionID,
+		&i.UserID,
+		&i.Kind,
+		&i.Version,
+		&i.Operation,
+		&i.Position,
+		&i.SelectionEnd,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastNoteEditID = `-- name: GetLastNoteEditID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS last_id
+FROM note_edits
+`
+
+func (q *Queries) GetLastNoteEditID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastNoteEditID)
+	var last_id int64
+	err := row.Scan(&last_id)
+	return last_id, err
+}
+
+const initNoteDocument = `-- name: InitNoteDocument :exec
+INSERT INTO note_documents (note_id, content, version, note_revision, snapshot_version, last_editor_id)
+SELECT id, content, 0, revision, 0, user_id
+FROM notes
+WHERE id = $1
+  AND deleted_at IS NULL
+ON CONFLICT (note_id) DO NOTHING
+`
+
+func (q *Queries) InitNoteDocument(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, initNoteDocument, id)
+	return err
+}
+
+const listDirtyNoteDocuments = `-- name: ListDirtyNoteDocuments :many
+SELECT d.note_id
+FROM note_documents d
+         JOIN notes n ON n.id = d.note_id
+WHERE d.version > d.snapshot_version
+  AND n.deleted_at IS NULL
+ORDER BY d.updated_at
+LIMIT $1
+`
+
+func (q *Queries) ListDirtyNoteDocuments(ctx context.Context, limit int32) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listDirtyNoteDocuments, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var note_id int64
+		if err := rows.Scan(&note_id); err != nil {
+			return nil, err
+		}
+		items = append(items, note_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNoteEditCursors = `-- name: ListNoteEditCursors :many
+SELECT DISTINCT ON (session_id) id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE note_id = $1
+  AND kind <> 'operation'
+  AND created_at > $2
+ORDER BY session_id, id DESC
+`
+
+type ListNoteEditCursorsParams struct {
+	NoteID       int64
+	CreatedAfter pgtype.Timestamptz
+}
+
+func (q *Queries) ListNoteEditCursors(ctx context.Context, arg ListNoteEditCursorsParams) ([]NoteEdit, error) {
+	rows, err := q.db.Query(ctx, listNoteEditCursors, arg.NoteID, arg.CreatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteEdit{}
+	for rows.Next() {
+		var i NoteEdit
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Kind,
+			&i.Version,
+			&i.Operation,
+			&i.Position,
+			&i.SelectionEnd,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNoteEditOperations = `-- name: ListNoteEditOperations :many
+SELECT id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE note_id = $1
+  AND kind = 'operation'
+  AND version > $2
+ORDER BY version
+`
+
+type ListNoteEditOperationsParams struct {
+	NoteID       int64
+	AfterVersion int64
+}
+
+func (q *Queries) ListNoteEditOperations(ctx context.Context, arg ListNoteEditOperationsParams) ([]NoteEdit, error) {
+	rows, err := q.db.Query(ctx, listNoteEditOperations, arg.NoteID, arg.AfterVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteEdit{}
+	for rows.Next() {
+		var i NoteEdit
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Kind,
+			&i.Version,
+			&i.Operation,
+			&i.Position,
+			&i.SelectionEnd,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNoteEditsAfter = `-- name: ListNoteEditsAfter :many
+SELECT id, note_id, session_id, user_id, kind, version, operation, position, selection_end, created_at
+FROM note_edits
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListNoteEditsAfterParams struct {
+	AfterID  int64
+	PageSize int32
+}
+
+func (q *Queries) ListNoteEditsAfter(ctx context.Context, arg ListNoteEditsAfterParams) ([]NoteEdit, error) {
+	rows, err := q.db.Query(ctx, listNoteEditsAfter, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteEdit{}
+	for rows.Next() {
+		var i NoteEdit
+		if err := rows.Scan(
+			&i.ID,
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Kind,
+			&i.Version,
+			&i.Operation,
+			&i.Position,
+			&i.SelectionEnd,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockNoteDocument = `-- name: LockNoteDocument :one
+SELECT note_id, content, version, note_revision, snapshot_version, last_editor_id, updated_at
+FROM note_documents
+WHERE note_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockNoteDocument(ctx context.Context, noteID int64) (NoteDocument, error) {
+	row := q.db.QueryRow(ctx, lockNoteDocument, noteID)
+	var i NoteDocument
+	err := row.Scan(
+		&i.NoteID,
+		&i.Content,
+		&i.Version,
+		&i.NoteRevision,
+		&i.SnapshotVersion,
+		&i.LastEditorID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockNoteEdits = `-- name: LockNoteEdits :exec
+SELECT pg_advisory_xact_lock($1)
+`
+
+// Held until the commit so ids commit in order for the edit relay.
+func (q *Queries) LockNoteEdits(ctx context.Context, pgAdvisoryXactLock int64) error {
+	_, err := q.db.Exec(ctx, lockNoteEdits, pgAdvisoryXactLock)
+	return err
+}
+
+const notifyNoteEdit = `-- name: NotifyNoteEdit :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyNoteEditParams struct {
+	Channel string
+	Payload string
+}
+
+func (q *Queries) NotifyNoteEdit(ctx context.Context, arg NotifyNoteEditParams) error {
+	_, err := q.db.Exec(ctx, notifyNoteEdit, arg.Channel, arg.Payload)
+	return err
+}
+
+const purgeNoteEdits = `-- name: PurgeNoteEdits :execrows
+DELETE FROM note_edits e
+USING note_documents d
+WHERE e.note_id = d.note_id
+  AND e.created_at < $1
+  AND (e.kind <> 'operation' OR e.version <= d.snapshot_version)
+`
+
+func (q *Queries) PurgeNoteEdits(ctx context.Context, createdBefore pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeNoteEdits, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateNoteDocument = `-- name: UpdateNoteDocument :exec
+UPDATE note_documents
+SET content          = $2,
+    version          = $3,
+    note_revision    = $4,
+    snapshot_version = $5,
+    last_editor_id   = $6,
+    updated_at       = now()
+WHERE note_id = $1
+`
+
+type UpdateNoteDocumentParams struct {
+	NoteID          int64
+	Content         string
+	Version         int64
+	NoteRevision    int64
+	SnapshotVersion int64
+	LastEditorID    int64
+}
+
+func (q *Queries) UpdateNoteDocument(ctx context.Context, arg UpdateNoteDocumentParams) error {
+	_, err := q.db.Exec(ctx, updateNoteDocument,
+		arg.NoteID,
+		arg.Content,
+		arg.Version,
+		arg.NoteRevision,
+		arg.SnapshotVersion,
+		arg.LastEditorID,
+	)
+	return err
+}
@@ -0,0 +1,188 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: events.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const appendNoteEvent = `-- name: AppendNoteEvent :one
+INSERT INTO note_events (type, note_id, recipients, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING sequence, type, note_id, recipients, payload, created_at
+`
+
+type AppendNoteEventParams struct {
+	Type       string
+	NoteID     int64
+	Recipients []int64
+	Payload    []byte
+}
+
+func (q *Queries) AppendNoteEvent(ctx context.Context, arg AppendNoteEventParams) (NoteEvent, error) {
+	row := q.db.QueryRow(ctx, appendNoteEvent,
+		arg.Type,
+		arg.NoteID,
+		arg.Recipients,
+		arg.Payload,
+	)
+	var i NoteEvent
+	err := row.Scan(
+		&i.Sequence,
+		&i.Type,
+		&i.NoteID,
+		&i.Recipients,
+		&i.Payload,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastNoteEventSequence = `-- name: GetLastNoteEventSequence :one
+SELECT COALESCE(MAX(sequence), 0)::bigint AS last_sequence
+FROM note_events
+`
+
+func (q *Queries) GetLastNoteEventSequence(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLastNoteEventSequence)
+	var last_sequence int64
+	err := row.Scan(&last_sequence)
+	return last_sequence, err
+}
+
+const listNoteEvents = `-- name: ListNoteEvents :many
+SELECT sequence, type, note_id, recipients, payload, created_at
+FROM note_events
+WHERE sequence > $1
+  AND recipients @> ARRAY[$2::bigint]
+ORDER BY sequence
+LIMIT $3
+`
+
+type ListNoteEventsParams struct {
+	AfterSequence int64
+	UserID        int64
+	PageSize      int32
+}
+
+func (q *Queries) ListNoteEvents(ctx context.Context, arg ListNoteEventsParams) ([]NoteEvent, error) {
+	rows, err := q.db.Query(ctx, listNoteEvents, arg.AfterSequence, arg.UserID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteEvent{}
+	for rows.Next() {
+		var i NoteEvent
+		if err := rows.Scan(
+			&i.Sequence,
+			&i.Type,
+			&i.NoteID,
+			&i.Recipients,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNoteEventsAfter = `-- name: ListNoteEventsAfter :many
+SELECT sequence, type, note_id, recipients, payload, created_at
+FROM note_events
+WHERE sequence > $1
+ORDER BY sequence
+LIMIT $2
+`
+
+type ListNoteEventsAfterParams struct {
+	AfterSequence int64
+	PageSize      int32
+}
+
+func (q *Queries) ListNoteEventsAfter(ctx context.Context, arg ListNoteEventsAfterParams) ([]NoteEvent, error) {
+	rows, err := q.db.Query(ctx, listNoteEventsAfter, arg.AfterSequence, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteEvent{}
+	for rows.Next() {
+		var i NoteEvent
+		if err := rows.Scan(
+			&i.Sequence,
+			&i.Type,
+			&i.NoteID,
+			&i.Recipients,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockNoteEvents = `-- name: LockNoteEvents :exec
+SELECT pg_advisory_xact_lock($1)
+`
+
+// Held until the commit so sequences commit in order for the log readers.
+func (q *Queries) LockNoteEvents(ctx context.Context, pgAdvisoryXactLock int64) error {
+	_, err := q.db.Exec(ctx, lockNoteEvents, pgAdvisoryXactLock)
+	return err
+}
+
+const notifyNoteEvent = `-- name: NotifyNoteEvent :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyNoteEventParams struct {
+	Channel string
+	Payload string
+}
+
+func (q *Queries) NotifyNoteEvent(ctx context.Context, arg NotifyNoteEventParams) error {
+	_, err := q.db.Exec(ctx, notifyNoteEvent, arg.Channel, arg.Payload)
+	return err
+}
+
+const purgeNoteEvents = `-- name: PurgeNoteEvents :execrows
+DELETE FROM note_events
+WHERE sequence IN (
+    SELECT expired.sequence
+    FROM note_events expired
+    WHERE expired.created_at < $1
+      AND expired.sequence <= (SELECT coalesce(max(sequence), 0) FROM webhook_cursor)
+    ORDER BY expired.sequence
+    LIMIT $2
+)
+`
+
+type PurgeNoteEventsParams struct {
+	CreatedBefore pgtype.Timestamptz
+	BatchSize     int32
+}
+
+// Events the webhook dispatcher has not reached yet are kept.
+func (q *Queries) PurgeNoteEvents(ctx context.Context, arg PurgeNoteEventsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeNoteEvents, arg.CreatedBefore, arg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
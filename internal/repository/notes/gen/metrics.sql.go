@@ -0,0 +1,225 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: metrics.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addNoteViews = `-- name: AddNoteViews :exec
+INSERT INTO note_view_stats (note_id, bucket, views)
+SELECT unnest($1::bigint[]),
+       unnest($2::timestamptz[]),
+       unnest($3::bigint[])
+ON CONFLICT (note_id, bucket) DO UPDATE SET views = note_view_stats.views + excluded.views
+`
+
+type AddNoteViewsParams struct {
+	NoteIds []int64
+	Buckets []pgtype.Timestamptz
+	Views   []int64
+}
+
+func (q *Queries) AddNoteViews(ctx context.Context, arg AddNoteViewsParams) error {
+	_, err := q.db.Exec(ctx, addNoteViews, arg.NoteIds, arg.Buckets, arg.Views)
+	return err
+}
+
+type CopyMetricRecordsParams struct {
+	UserID   int64
+	BatchID  pgtype.UUID
+	Sequence int64
+	NoteID   int64
+	Bucket   pgtype.Timestamptz
+	Views    int64
+}
+
+const getNoteViewStats = `-- name: GetNoteViewStats :many
+SELECT s.bucket::timestamptz AS bucket, COALESCE(SUM(v.views), 0)::bigint AS views
+FROM generate_series(
+         $1::timestamptz,
+         $2::timestamptz,
+         $3::bigint * interval '1 second'
+     ) AS s(bucket)
+         LEFT JOIN note_view_stats v
+                   ON v.note_id = $4
+                       AND v.bucket >= s.bucket
+                       AND v.bucket < s.bucket + $3::bigint * interval '1 second'
+GROUP BY s.bucket
+ORDER BY s.bucket
+`
+
+type GetNoteViewStatsParams struct {
+	FromBucket  pgtype.Timestamptz
+	LastBucket  pgtype.Timestamptz
+	StepSeconds int64
+	NoteID      int64
+}
+
+type GetNoteViewStatsRow struct {
+	Bucket pgtype.Timestamptz
+	Views  int64
+}
+
+func (q *Queries) GetNoteViewStats(ctx context.Context, arg GetNoteViewStatsParams) ([]GetNoteViewStatsRow, error) {
+	rows, err := q.db.Query(ctx, getNoteViewStats,
+		arg.FromBucket,
+		arg.LastBucket,
+		arg.StepSeconds,
+		arg.NoteID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetNoteViewStatsRow{}
+	for rows.Next() {
+		var i GetNoteViewStatsRow
+		if err := rows.Scan(&i.Bucket, &i.Views); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMetricRecordSequences = `-- name: ListMetricRecordSequences :many
+SELECT sequence
+FROM metric_records
+WHERE user_id = $1
+  AND batch_id = $2
+  AND sequence = ANY ($3::bigint[])
+`
+
+type ListMetricRecordSequencesParams struct {
+	UserID    int64
+	BatchID   pgtype.UUID
+	Sequences []int64
+}
+
+func (q *Queries) ListMetricRecordSequences(ctx context.Context, arg ListMetricRecordSequencesParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listMetricRecordSequences, arg.UserID, arg.BatchID, arg.Sequences)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var sequence int64
+		if err := rows.Scan(&sequence); err != nil {
+			return nil, err
+		}
+		items = append(items, sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopNotes = `-- name: ListTopNotes :many
+SELECT n.id, n.user_id, n.title, n.content, n.created_at, n.updated_at, n.revision, n.notebook_id,
+       SUM(v.views)::bigint AS views
+FROM note_view_stats v
+         JOIN notes n ON n.id = v.note_id
+WHERE v.bucket >= $1
+  AND v.bucket < $2
+  AND n.deleted_at IS NULL
+  AND (n.user_id = $3 OR n.id IN (
+    SELECT note_id
+    FROM note_collaborators
+    WHERE collaborator_id = $3))
+GROUP BY n.id
+ORDER BY views DESC, n.id
+LIMIT $4
+`
+
+type ListTopNotesParams struct {
+	FromBucket pgtype.Timestamptz
+	ToBucket   pgtype.Timestamptz
+	UserID     int64
+	PageSize   int32
+}
+
+type ListTopNotesRow struct {
+	ID         int64
+	UserID     int64
+	Title      string
+	Content    string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	Revision   int64
+	NotebookID *int64
+	Views      int64
+}
+
+func (q *Queries) ListTopNotes(ctx context.Context, arg ListTopNotesParams) ([]ListTopNotesRow, error) {
+	rows, err := q.db.Query(ctx, listTopNotes,
+		arg.FromBucket,
+		arg.ToBucket,
+		arg.UserID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTopNotesRow{}
+	for rows.Next() {
+		var i ListTopNotesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.NotebookID,
+			&i.Views,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockMetricsBatch = `-- name: LockMetricsBatch :exec
+SELECT pg_advisory_xact_lock(hashtextextended($1::text, $2::bigint))
+`
+
+type LockMetricsBatchParams struct {
+	BatchID string
+	UserID  int64
+}
+
+func (q *Queries) LockMetricsBatch(ctx context.Context, arg LockMetricsBatchParams) error {
+	_, err := q.db.Exec(ctx, lockMetricsBatch, arg.BatchID, arg.UserID)
+	return err
+}
+
+const purgeMetricRecords = `-- name: PurgeMetricRecords :execrows
+DELETE
+FROM metric_records
+WHERE received_at < $1
+`
+
+func (q *Queries) PurgeMetricRecords(ctx context.Context, receivedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeMetricRecords, receivedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -8,11 +8,136 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Note struct {
+type Attachment struct {
+	ID          int64
+	NoteID      *int64
+	StorageKey  string
+	Filename    string
+	ContentType string
+	Size        int64
+	Sha256      []byte
+	CreatedBy   int64
+	CreatedAt   pgtype.Timestamptz
+}
+
+type ChatMessage struct {
 	ID        int64
+	NoteID    int64
+	UserID    int64
+	Content   string
+	CreatedAt pgtype.Timestamptz
+}
+
+type Note struct {
+	ID         int64
+	UserID     int64
+	Title      string
+	Content    string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	Revision   int64
+	DeletedAt  pgtype.Timestamptz
+	NotebookID *int64
+}
+
+type NoteCollaborator struct {
+	NoteID         int64
+	CollaboratorID int64
+	Role           string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type NoteDocument struct {
+	NoteID          int64
+	Content         string
+	Version         int64
+	NoteRevision    int64
+	SnapshotVersion int64
+	LastEditorID    int64
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type NoteEdit struct {
+	ID           int64
+	NoteID       int64
+	SessionID    pgtype.UUID
+	UserID       int64
+	Kind         string
+	Version      int64
+	Operation    []byte
+	Position     *int64
+	SelectionEnd *int64
+	CreatedAt    pgtype.Timestamptz
+}
+
+type NoteEvent struct {
+	Sequence   int64
+	Type       string
+	NoteID     int64
+	Recipients []int64
+	Payload    []byte
+	CreatedAt  pgtype.Timestamptz
+}
+
+type NotePresence struct {
+	NoteID    int64
+	SessionID pgtype.UUID
 	UserID    int64
+	Activity  string
+	JoinedAt  pgtype.Timestamptz
+	ExpiresAt pgtype.Timestamptz
+}
+
+type NoteRevision struct {
+	NoteID    int64
+	Revision  int64
+	AuthorID  int64
 	Title     string
 	Content   string
 	CreatedAt pgtype.Timestamptz
+}
+
+type Notebook struct {
+	ID        int64
+	UserID    int64
+	ParentID  *int64
+	Name      string
+	Path      string
+	CreatedAt pgtype.Timestamptz
 	UpdatedAt pgtype.Timestamptz
 }
+
+type ShareLink struct {
+	ID           int64
+	NoteID       int64
+	TokenHash    []byte
+	PasswordHash *string
+	ExpiresAt    pgtype.Timestamptz
+	RevokedAt    pgtype.Timestamptz
+	CreatedBy    int64
+	CreatedAt    pgtype.Timestamptz
+}
+
+type Webhook struct {
+	ID         int64
+	UserID     int64
+	Url        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventSequence  int64
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	ResponseStatus *int32
+	LastError      *string
+	CreatedAt      pgtype.Timestamptz
+	CompletedAt    pgtype.Timestamptz
+	NextAttemptAt  pgtype.Timestamptz
+}
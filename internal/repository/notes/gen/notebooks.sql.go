@@ -0,0 +1,278 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: notebooks.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createNotebook = `-- name: CreateNotebook :one
+WITH new_notebook AS (
+    SELECT nextval(pg_get_serial_sequence('notebooks', 'id')) AS id
+)
+INSERT INTO notebooks (id, user_id, parent_id, name, path)
+SELECT new_notebook.id,
+       $1,
+       $2,
+       $3,
+       $4::text || new_notebook.id || '/'
+FROM new_notebook
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at
+`
+
+type CreateNotebookParams struct {
+	UserID     int64
+	ParentID   *int64
+	Name       string
+	ParentPath string
+}
+
+func (q *Queries) CreateNotebook(ctx context.Context, arg CreateNotebookParams) (Notebook, error) {
+	row := q.db.QueryRow(ctx, createNotebook,
+		arg.UserID,
+		arg.ParentID,
+		arg.Name,
+		arg.ParentPath,
+	)
+	var i Notebook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Path,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteNotebook = `-- name: DeleteNotebook :execrows
+DELETE
+FROM notebooks
+WHERE id = $1
+  AND user_id = $2
+`
+
+type DeleteNotebookParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteNotebook(ctx context.Context, arg DeleteNotebookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteNotebook, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getNotebook = `-- name: GetNotebook :one
+SELECT id, user_id, parent_id, name, path, created_at, updated_at
+FROM notebooks
+WHERE id = $1
+  AND user_id = $2
+`
+
+type GetNotebookParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) GetNotebook(ctx context.Context, arg GetNotebookParams) (Notebook, error) {
+	row := q.db.QueryRow(ctx, getNotebook, arg.ID, arg.UserID)
+	var i Notebook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Path,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listChildNotebooks = `-- name: ListChildNotebooks :many
+SELECT id, user_id, parent_id, name, path, created_at, updated_at
+FROM notebooks
+WHERE user_id = $1
+  AND parent_id IS NOT DISTINCT FROM $2
+ORDER BY name, id
+`
+
+type ListChildNotebooksParams struct {
+	UserID   int64
+	ParentID *int64
+}
+
+func (q *Queries) ListChildNotebooks(ctx context.Context, arg ListChildNotebooksParams) ([]Notebook, error) {
+	rows, err := q.db.Query(ctx, listChildNotebooks, arg.UserID, arg.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Notebook{}
+	for rows.Next() {
+		var i Notebook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.Path,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotebookSubtree = `-- name: ListNotebookSubtree :many
+SELECT nb.id, nb.user_id, nb.parent_id, nb.name, nb.path, nb.created_at, nb.updated_at,
+       count(n.id) AS note_count
+FROM notebooks nb
+         LEFT JOIN notes n ON n.notebook_id = nb.id AND n.deleted_at IS NULL
+WHERE nb.user_id = $1
+  AND nb.path LIKE $2::text || '%'
+GROUP BY nb.id
+ORDER BY nb.path
+`
+
+type ListNotebookSubtreeParams struct {
+	UserID     int64
+	PathPrefix string
+}
+
+type ListNotebookSubtreeRow struct {
+	ID        int64
+	UserID    int64
+	ParentID  *int64
+	Name      string
+	Path      string
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+	NoteCount int64
+}
+
+func (q *Queries) ListNotebookSubtree(ctx context.Context, arg ListNotebookSubtreeParams) ([]ListNotebookSubtreeRow, error) {
+	rows, err := q.db.Query(ctx, listNotebookSubtree, arg.UserID, arg.PathPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListNotebookSubtreeRow{}
+	for rows.Next() {
+		var i ListNotebookSubtreeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ParentID,
+			&i.Name,
+			&i.Path,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.NoteCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveNotebook = `-- name: MoveNotebook :one
+UPDATE notebooks
+SET parent_id  = $1,
+    updated_at = now()
+WHERE id = $2
+  AND user_id = $3
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at
+`
+
+type MoveNotebookParams struct {
+	ParentID *int64
+	ID       int64
+	UserID   int64
+}
+
+func (q *Queries) MoveNotebook(ctx context.Context, arg MoveNotebookParams) (Notebook, error) {
+	row := q.db.QueryRow(ctx, moveNotebook, arg.ParentID, arg.ID, arg.UserID)
+	var i Notebook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Path,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const renameNotebook = `-- name: RenameNotebook :one
+UPDATE notebooks
+SET name       = $1,
+    updated_at = now()
+WHERE id = $2
+  AND user_id = $3
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at
+`
+
+type RenameNotebookParams struct {
+	Name   string
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) RenameNotebook(ctx context.Context, arg RenameNotebookParams) (Notebook, error) {
+	row := q.db.QueryRow(ctx, renameNotebook, arg.Name, arg.ID, arg.UserID)
+	var i Notebook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ParentID,
+		&i.Name,
+		&i.Path,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const rewriteNotebookPaths = `-- name: RewriteNotebookPaths :execrows
+UPDATE notebooks
+SET path = $1::text || substr(path, length($2::text) + 1)
+WHERE user_id = $3
+  AND path LIKE $2::text || '%'
+`
+
+type RewriteNotebookPathsParams struct {
+	NewPrefix string
+	OldPrefix string
+	UserID    int64
+}
+
+func (q *Queries) RewriteNotebookPaths(ctx context.Context, arg RewriteNotebookPathsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rewriteNotebookPaths, arg.NewPrefix, arg.OldPrefix, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
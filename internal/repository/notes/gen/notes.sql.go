@@ -7,12 +7,14 @@ package notesrepo
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createNote = `-- name: CreateNote :one
 INSERT INTO notes (user_id, title, content)
 VALUES ($1, $2, $3)
-RETURNING id, user_id, title, content, created_at, updated_at
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 `
 
 type CreateNoteParams struct {
@@ -31,23 +33,50 @@ func (q *Queries) CreateNote(ctx context.Context, arg CreateNoteParams) (Note, e
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
 	)
 	return i, err
 }
 
-const deleteNote = `-- name: DeleteNote :exec
-DELETE FROM notes WHERE id = $1
+const deleteNote = `-- name: DeleteNote :one
+UPDATE notes
+SET deleted_at = now(),
+    revision   = revision + 1
+WHERE id = $1
+  AND revision = $2
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 `
 
-func (q *Queries) DeleteNote(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteNote, id)
-	return err
+type DeleteNoteParams struct {
+	ID       int64
+	Revision int64
+}
+
+func (q *Queries) DeleteNote(ctx context.Context, arg DeleteNoteParams) (Note, error) {
+	row := q.db.QueryRow(ctx, deleteNote, arg.ID, arg.Revision)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
+	)
+	return i, err
 }
 
 const getNote = `-- name: GetNote :one
-SELECT id, user_id, title, content, created_at, updated_at
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 FROM notes
 WHERE id = $1
+  AND deleted_at IS NULL
 `
 
 func (q *Queries) GetNote(ctx context.Context, id int64) (Note, error) {
@@ -60,19 +89,539 @@ func (q *Queries) GetNote(ctx context.Context, id int64) (Note, error) {
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
 	)
 	return i, err
 }
 
-const getNotesByUserID = `-- name: GetNotesByUserID :many
-SELECT id, user_id, title, content, created_at, updated_at
+const listNotesByCreatedAtAsc = `-- name: ListNotesByCreatedAtAsc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (created_at, id) > ($9::timestamptz, $8::bigint))
+ORDER BY created_at ASC, id ASC
+LIMIT $10
+`
+
+type ListNotesByCreatedAtAscParams struct {
+	UserID         int64
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	UpdatedFrom    pgtype.Timestamptz
+	UpdatedTo      pgtype.Timestamptz
+	TagIds         []int64
+	MatchAllTags   bool
+	AfterID        *int64
+	AfterCreatedAt pgtype.Timestamptz
+	PageSize       int32
+}
+
+// Own and shared notes are read by separate branches of the union, so
+// the own notes branch walks idx_notes_user_id_created_at from the keyset,
+// the other ListNotesBy queries differ only in the keyset column and order.
+func (q *Queries) ListNotesByCreatedAtAsc(ctx context.Context, arg ListNotesByCreatedAtAscParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByCreatedAtAsc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByCreatedAtDesc = `-- name: ListNotesByCreatedAtDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (created_at, id) < ($9::timestamptz, $8::bigint))
+ORDER BY created_at DESC, id DESC
+LIMIT $10
+`
+
+type ListNotesByCreatedAtDescParams struct {
+	UserID         int64
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	UpdatedFrom    pgtype.Timestamptz
+	UpdatedTo      pgtype.Timestamptz
+	TagIds         []int64
+	MatchAllTags   bool
+	AfterID        *int64
+	AfterCreatedAt pgtype.Timestamptz
+	PageSize       int32
+}
+
+func (q *Queries) ListNotesByCreatedAtDesc(ctx context.Context, arg ListNotesByCreatedAtDescParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByCreatedAtDesc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterCreatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByTitleAsc = `-- name: ListNotesByTitleAsc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (title, id) > ($9::text, $8::bigint))
+ORDER BY title ASC, id ASC
+LIMIT $10
+`
+
+type ListNotesByTitleAscParams struct {
+	UserID       int64
+	CreatedFrom  pgtype.Timestamptz
+	CreatedTo    pgtype.Timestamptz
+	UpdatedFrom  pgtype.Timestamptz
+	UpdatedTo    pgtype.Timestamptz
+	TagIds       []int64
+	MatchAllTags bool
+	AfterID      *int64
+	AfterTitle   *string
+	PageSize     int32
+}
+
+func (q *Queries) ListNotesByTitleAsc(ctx context.Context, arg ListNotesByTitleAscParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByTitleAsc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByTitleDesc = `-- name: ListNotesByTitleDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (title, id) < ($9::text, $8::bigint))
+ORDER BY title DESC, id DESC
+LIMIT $10
+`
+
+type ListNotesByTitleDescParams struct {
+	UserID       int64
+	CreatedFrom  pgtype.Timestamptz
+	CreatedTo    pgtype.Timestamptz
+	UpdatedFrom  pgtype.Timestamptz
+	UpdatedTo    pgtype.Timestamptz
+	TagIds       []int64
+	MatchAllTags bool
+	AfterID      *int64
+	AfterTitle   *string
+	PageSize     int32
+}
+
+func (q *Queries) ListNotesByTitleDesc(ctx context.Context, arg ListNotesByTitleDescParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByTitleDesc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterTitle,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByUpdatedAtAsc = `-- name: ListNotesByUpdatedAtAsc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (updated_at, id) > ($9::timestamptz, $8::bigint))
+ORDER BY updated_at ASC, id ASC
+LIMIT $10
+`
+
+type ListNotesByUpdatedAtAscParams struct {
+	UserID         int64
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	UpdatedFrom    pgtype.Timestamptz
+	UpdatedTo      pgtype.Timestamptz
+	TagIds         []int64
+	MatchAllTags   bool
+	AfterID        *int64
+	AfterUpdatedAt pgtype.Timestamptz
+	PageSize       int32
+}
+
+func (q *Queries) ListNotesByUpdatedAtAsc(ctx context.Context, arg ListNotesByUpdatedAtAscParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByUpdatedAtAsc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterUpdatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotesByUpdatedAtDesc = `-- name: ListNotesByUpdatedAtDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = $1
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = $1
+        AND shared.user_id <> $1) visible
+WHERE deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+  AND ($4::timestamptz IS NULL OR updated_at >= $4)
+  AND ($5::timestamptz IS NULL OR updated_at < $5)
+  AND ($6::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY ($6::bigint[])
+    GROUP BY note_id
+    HAVING NOT $7::bool
+        OR count(*) = cardinality($6::bigint[])))
+  AND ($8::bigint IS NULL
+    OR (updated_at, id) < ($9::timestamptz, $8::bigint))
+ORDER BY updated_at DESC, id DESC
+LIMIT $10
+`
+
+type ListNotesByUpdatedAtDescParams struct {
+	UserID         int64
+	CreatedFrom    pgtype.Timestamptz
+	CreatedTo      pgtype.Timestamptz
+	UpdatedFrom    pgtype.Timestamptz
+	UpdatedTo      pgtype.Timestamptz
+	TagIds         []int64
+	MatchAllTags   bool
+	AfterID        *int64
+	AfterUpdatedAt pgtype.Timestamptz
+	PageSize       int32
+}
+
+func (q *Queries) ListNotesByUpdatedAtDesc(ctx context.Context, arg ListNotesByUpdatedAtDescParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listNotesByUpdatedAtDesc,
+		arg.UserID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.TagIds,
+		arg.MatchAllTags,
+		arg.AfterID,
+		arg.AfterUpdatedAt,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Note{}
+	for rows.Next() {
+		var i Note
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedNotes = `-- name: ListTrashedNotes :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 FROM notes
 WHERE user_id = $1
-ORDER BY created_at DESC
+  AND deleted_at IS NOT NULL
+  AND ($2::bigint IS NULL
+    OR (deleted_at, id) < ($3::timestamptz, $2::bigint))
+ORDER BY deleted_at DESC, id DESC
+LIMIT $4
 `
 
-func (q *Queries) GetNotesByUserID(ctx context.Context, userID int64) ([]Note, error) {
-	rows, err := q.db.Query(ctx, getNotesByUserID, userID)
+type ListTrashedNotesParams struct {
+	UserID         int64
+	AfterID        *int64
+	AfterDeletedAt pgtype.Timestamptz
+	PageSize       int32
+}
+
+func (q *Queries) ListTrashedNotes(ctx context.Context, arg ListTrashedNotesParams) ([]Note, error) {
+	rows, err := q.db.Query(ctx, listTrashedNotes,
+		arg.UserID,
+		arg.AfterID,
+		arg.AfterDeletedAt,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +636,219 @@ func (q *Queries) GetNotesByUserID(ctx context.Context, userID int64) ([]Note, e
 			&i.Content,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Revision,
+			&i.DeletedAt,
+			&i.NotebookID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveNote = `-- name: MoveNote :one
+UPDATE notes
+SET notebook_id = $1,
+    revision    = revision + 1,
+    updated_at  = now()
+WHERE id = $2
+  AND revision = $3
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+`
+
+type MoveNoteParams struct {
+	NotebookID *int64
+	ID         int64
+	Revision   int64
+}
+
+func (q *Queries) MoveNote(ctx context.Context, arg MoveNoteParams) (Note, error) {
+	row := q.db.QueryRow(ctx, moveNote, arg.NotebookID, arg.ID, arg.Revision)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
+	)
+	return i, err
+}
+
+const purgeDeletedNotes = `-- name: PurgeDeletedNotes :one
+WITH purged AS (
+    DELETE FROM notes
+    WHERE id IN (
+        SELECT trashed.id
+        FROM notes trashed
+        WHERE trashed.deleted_at < $1
+        LIMIT $2
+    )
+    RETURNING id
+), scrubbed AS (
+    UPDATE note_events e
+    SET payload = jsonb_set(e.payload, '{note}', jsonb_build_object('ID', e.note_id, 'UserID', e.payload -> 'note' -> 'UserID'))
+    FROM purged
+    WHERE e.note_id = purged.id
+)
+SELECT count(*) FROM purged
+`
+
+type PurgeDeletedNotesParams struct {
+	DeletedBefore pgtype.Timestamptz
+	BatchSize     int32
+}
+
+// Events of the purged notes are scrubbed as in PurgeNote.
+func (q *Queries) PurgeDeletedNotes(ctx context.Context, arg PurgeDeletedNotesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, purgeDeletedNotes, arg.DeletedBefore, arg.BatchSize)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const purgeNote = `-- name: PurgeNote :one
+WITH purged AS (
+    DELETE FROM notes
+    WHERE id = $1
+      AND deleted_at IS NOT NULL
+    RETURNING id
+), scrubbed AS (
+    UPDATE note_events e
+    SET payload = jsonb_set(e.payload, '{note}', jsonb_build_object('ID', e.note_id, 'UserID', e.payload -> 'note' -> 'UserID'))
+    FROM purged
+    WHERE e.note_id = purged.id
+)
+SELECT count(*) FROM purged
+`
+
+// Events of the purged note are scrubbed down to the note and owner ids,
+// the title and content do not outlive the note in the event log.
+func (q *Queries) PurgeNote(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRow(ctx, purgeNote, id)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const restoreNote = `-- name: RestoreNote :one
+UPDATE notes
+SET deleted_at = NULL,
+    revision   = revision + 1
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+`
+
+func (q *Queries) RestoreNote(ctx context.Context, id int64) (Note, error) {
+	row := q.db.QueryRow(ctx, restoreNote, id)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
+	)
+	return i, err
+}
+
+const searchNotes = `-- name: SearchNotes :many
+WITH search AS (
+    SELECT websearch_to_tsquery(($1::text)::regconfig, $5::text) AS query
+),
+ranked AS (
+    SELECT n.id, n.user_id, n.title, n.content, n.created_at, n.updated_at, n.revision, n.notebook_id,
+           ts_rank_cd(notes_search_vector(n.title, n.content), search.query) AS rank
+    FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+          FROM notes own
+          WHERE own.user_id = $6
+          UNION ALL
+          SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+          FROM notes shared
+              JOIN note_collaborators c ON c.note_id = shared.id
+          WHERE c.collaborator_id = $6
+            AND shared.user_id <> $6) n, search
+    WHERE n.deleted_at IS NULL
+      AND notes_search_vector(n.title, n.content) @@ search.query
+)
+SELECT ranked.id, ranked.user_id, ranked.title, ranked.content, ranked.created_at, ranked.updated_at, ranked.revision,
+       ranked.notebook_id,
+       ranked.rank::real AS rank,
+       ts_headline(($1::text)::regconfig,
+                   translate(ranked.title || E'\n' || ranked.content, E'\uE000\uE001', ''), search.query,
+                   E'MaxFragments=2, MinWords=5, MaxWords=20, StartSel=\uE000, StopSel=\uE001')::text AS snippet
+FROM ranked, search
+WHERE $2::bigint IS NULL
+   OR (ranked.rank, ranked.id) < ($3::real, $2::bigint)
+ORDER BY ranked.rank DESC, ranked.id DESC
+LIMIT $4
+`
+
+type SearchNotesParams struct {
+	Language  string
+	AfterID   *int64
+	AfterRank *float32
+	PageSize  int32
+	Query     string
+	UserID    int64
+}
+
+type SearchNotesRow struct {
+	ID         int64
+	UserID     int64
+	Title      string
+	Content    string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	Revision   int64
+	NotebookID *int64
+	Rank       float32
+	Snippet    string
+}
+
+// Matched terms in the snippet are wrapped in U+E000 and U+E001, the repository
+// turns them into offsets, so the markers are stripped from the text first.
+func (q *Queries) SearchNotes(ctx context.Context, arg SearchNotesParams) ([]SearchNotesRow, error) {
+	rows, err := q.db.Query(ctx, searchNotes,
+		arg.Language,
+		arg.AfterID,
+		arg.AfterRank,
+		arg.PageSize,
+		arg.Query,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchNotesRow{}
+	for rows.Next() {
+		var i SearchNotesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Revision,
+			&i.NotebookID,
+			&i.Rank,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -97,3 +859,44 @@ func (q *Queries) GetNotesByUserID(ctx context.Context, userID int64) ([]Note, e
 	}
 	return items, nil
 }
+
+const updateNote = `-- name: UpdateNote :one
+UPDATE notes
+SET title      = COALESCE($1, title),
+    content    = COALESCE($2, content),
+    updated_at = now(),
+    revision   = revision + 1
+WHERE id = $3
+  AND revision = $4
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+`
+
+type UpdateNoteParams struct {
+	Title    *string
+	Content  *string
+	ID       int64
+	Revision int64
+}
+
+func (q *Queries) UpdateNote(ctx context.Context, arg UpdateNoteParams) (Note, error) {
+	row := q.db.QueryRow(ctx, updateNote,
+		arg.Title,
+		arg.Content,
+		arg.ID,
+		arg.Revision,
+	)
+	var i Note
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Revision,
+		&i.DeletedAt,
+		&i.NotebookID,
+	)
+	return i, err
+}
@@ -0,0 +1,234 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: presence.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUserPresence = `-- name: CountUserPresence :one
+SELECT count(*)
+FROM note_presence
+WHERE note_id = $1
+  AND user_id = $2
+  AND expires_at > now()
+`
+
+type CountUserPresenceParams struct {
+	NoteID int64
+	UserID int64
+}
+
+func (q *Queries) CountUserPresence(ctx context.Context, arg CountUserPresenceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserPresence, arg.NoteID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPresence = `-- name: CreatePresence :one
+INSERT INTO note_presence (note_id, session_id, user_id, activity, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING note_id, session_id, user_id, activity, joined_at, expires_at
+`
+
+type CreatePresenceParams struct {
+	NoteID    int64
+	SessionID pgtype.UUID
+	UserID    int64
+	Activity  string
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreatePresence(ctx context.Context, arg CreatePresenceParams) (NotePresence, error) {
+	row := q.db.QueryRow(ctx, createPresence,
+		arg.NoteID,
+		arg.SessionID,
+		arg.UserID,
+		arg.Activity,
+		arg.ExpiresAt,
+	)
+	var i NotePresence
+	err := row.Scan(
+		&i.NoteID,
+		&i.SessionID,
+		&i.UserID,
+		&i.Activity,
+		&i.JoinedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const deleteExpiredPresence = `-- name: DeleteExpiredPresence :execrows
+DELETE FROM note_presence
+WHERE note_id = $1
+  AND session_id = $2
+  AND expires_at < now()
+`
+
+type DeleteExpiredPresenceParams struct {
+	NoteID    int64
+	SessionID pgtype.UUID
+}
+
+func (q *Queries) DeleteExpiredPresence(ctx context.Context, arg DeleteExpiredPresenceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredPresence, arg.NoteID, arg.SessionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePresence = `-- name: DeletePresence :exec
+DELETE FROM note_presence
+WHERE note_id = $1
+  AND session_id = $2
+`
+
+type DeletePresenceParams struct {
+	NoteID    int64
+	SessionID pgtype.UUID
+}
+
+func (q *Queries) DeletePresence(ctx context.Context, arg DeletePresenceParams) error {
+	_, err := q.db.Exec(ctx, deletePresence, arg.NoteID, arg.SessionID)
+	return err
+}
+
+const listExpiredPresence = `-- name: ListExpiredPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE expires_at < now()
+ORDER BY note_id
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredPresence(ctx context.Context, limit int32) ([]NotePresence, error) {
+	rows, err := q.db.Query(ctx, listExpiredPresence, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotePresence{}
+	for rows.Next() {
+		var i NotePresence
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Activity,
+			&i.JoinedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPresence = `-- name: ListPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE note_id = $1
+  AND expires_at > now()
+ORDER BY joined_at
+`
+
+func (q *Queries) ListPresence(ctx context.Context, noteID int64) ([]NotePresence, error) {
+	rows, err := q.db.Query(ctx, listPresence, noteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotePresence{}
+	for rows.Next() {
+		var i NotePresence
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Activity,
+			&i.JoinedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionPresence = `-- name: ListSessionPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE session_id = $1
+ORDER BY note_id
+`
+
+func (q *Queries) ListSessionPresence(ctx context.Context, sessionID pgtype.UUID) ([]NotePresence, error) {
+	rows, err := q.db.Query(ctx, listSessionPresence, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NotePresence{}
+	for rows.Next() {
+		var i NotePresence
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.SessionID,
+			&i.UserID,
+			&i.Activity,
+			&i.JoinedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockNotePresence = `-- name: LockNotePresence :exec
+SELECT pg_advisory_xact_lock(hashtextextended('note_presence', $1::bigint))
+`
+
+func (q *Queries) LockNotePresence(ctx context.Context, noteID int64) error {
+	_, err := q.db.Exec(ctx, lockNotePresence, noteID)
+	return err
+}
+
+const refreshPresence = `-- name: RefreshPresence :execrows
+UPDATE note_presence
+SET expires_at = $2
+WHERE session_id = $1
+`
+
+type RefreshPresenceParams struct {
+	SessionID pgtype.UUID
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) RefreshPresence(ctx context.Context, arg RefreshPresenceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, refreshPresence, arg.SessionID, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -6,13 +6,132 @@ package notesrepo
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddNoteTags(ctx context.Context, arg AddNoteTagsParams) error
+	AddNoteViews(ctx context.Context, arg AddNoteViewsParams) error
+	AppendNoteEdit(ctx context.Context, arg AppendNoteEditParams) (NoteEdit, error)
+	AppendNoteEvent(ctx context.Context, arg AppendNoteEventParams) (NoteEvent, error)
+	// The claimed delivery is leased by moving its next attempt past the lease,
+	// other dispatchers pick it up again only if the attempt is not recorded
+	// until then.
+	ClaimWebhookDelivery(ctx context.Context, leaseSeconds float64) (ClaimWebhookDeliveryRow, error)
+	CopyMetricRecords(ctx context.Context, arg []CopyMetricRecordsParams) (int64, error)
+	CountUserPresence(ctx context.Context, arg CountUserPresenceParams) (int64, error)
+	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error)
+	CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error)
 	CreateNote(ctx context.Context, arg CreateNoteParams) (Note, error)
-	DeleteNote(ctx context.Context, id int64) error
+	CreateNoteRevision(ctx context.Context, arg CreateNoteRevisionParams) (NoteRevision, error)
+	CreateNotebook(ctx context.Context, arg CreateNotebookParams) (Notebook, error)
+	CreatePresence(ctx context.Context, arg CreatePresenceParams) (NotePresence, error)
+	CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (ShareLink, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	DeleteAttachments(ctx context.Context, ids []int64) error
+	DeleteCollaborator(ctx context.Context, arg DeleteCollaboratorParams) (int64, error)
+	DeleteExpiredPresence(ctx context.Context, arg DeleteExpiredPresenceParams) (int64, error)
+	DeleteNote(ctx context.Context, arg DeleteNoteParams) (Note, error)
+	DeleteNoteTags(ctx context.Context, noteID int64) error
+	DeleteNotebook(ctx context.Context, arg DeleteNotebookParams) (int64, error)
+	DeletePresence(ctx context.Context, arg DeletePresenceParams) error
+	DeleteTags(ctx context.Context, arg DeleteTagsParams) (int64, error)
+	DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error)
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	GetAttachment(ctx context.Context, arg GetAttachmentParams) (Attachment, error)
+	GetLastChatMessageID(ctx context.Context) (int64, error)
+	GetLastNoteEditID(ctx context.Context) (int64, error)
+	GetLastNoteEventSequence(ctx context.Context) (int64, error)
 	GetNote(ctx context.Context, id int64) (Note, error)
-	GetNotesByUserID(ctx context.Context, userID int64) ([]Note, error)
+	GetNoteRevision(ctx context.Context, arg GetNoteRevisionParams) (NoteRevision, error)
+	GetNoteRole(ctx context.Context, arg GetNoteRoleParams) (string, error)
+	GetNoteViewStats(ctx context.Context, arg GetNoteViewStatsParams) ([]GetNoteViewStatsRow, error)
+	GetNotebook(ctx context.Context, arg GetNotebookParams) (Notebook, error)
+	GetSharedNote(ctx context.Context, tokenHash []byte) (GetSharedNoteRow, error)
+	GetTagIDs(ctx context.Context, arg GetTagIDsParams) ([]int64, error)
+	// A NULL user_id matches webhooks of any user.
+	GetWebhook(ctx context.Context, arg GetWebhookParams) (Webhook, error)
+	InitNoteDocument(ctx context.Context, id int64) error
+	InitWebhookCursor(ctx context.Context) error
+	ListAttachments(ctx context.Context, noteID *int64) ([]Attachment, error)
+	ListChatMessages(ctx context.Context, arg ListChatMessagesParams) ([]ChatMessage, error)
+	ListChatMessagesAfter(ctx context.Context, arg ListChatMessagesAfterParams) ([]ChatMessage, error)
+	ListChildNotebooks(ctx context.Context, arg ListChildNotebooksParams) ([]Notebook, error)
+	ListCollaborators(ctx context.Context, noteID int64) ([]NoteCollaborator, error)
+	ListDirtyNoteDocuments(ctx context.Context, limit int32) ([]int64, error)
+	ListExpiredPresence(ctx context.Context, limit int32) ([]NotePresence, error)
+	ListMetricRecordSequences(ctx context.Context, arg ListMetricRecordSequencesParams) ([]int64, error)
+	ListNoteEditCursors(ctx context.Context, arg ListNoteEditCursorsParams) ([]NoteEdit, error)
+	ListNoteEditOperations(ctx context.Context, arg ListNoteEditOperationsParams) ([]NoteEdit, error)
+	ListNoteEditsAfter(ctx context.Context, arg ListNoteEditsAfterParams) ([]NoteEdit, error)
+	ListNoteEvents(ctx context.Context, arg ListNoteEventsParams) ([]NoteEvent, error)
+	ListNoteEventsAfter(ctx context.Context, arg ListNoteEventsAfterParams) ([]NoteEvent, error)
+	ListNoteRevisions(ctx context.Context, arg ListNoteRevisionsParams) ([]NoteRevision, error)
+	ListNoteTags(ctx context.Context, noteIds []int64) ([]ListNoteTagsRow, error)
+	ListNotebookSubtree(ctx context.Context, arg ListNotebookSubtreeParams) ([]ListNotebookSubtreeRow, error)
+	// Own and shared notes are read by separate branches of the union, so
+	// the own notes branch walks idx_notes_user_id_created_at from the keyset,
+	// the other ListNotesBy queries differ only in the keyset column and order.
+	ListNotesByCreatedAtAsc(ctx context.Context, arg ListNotesByCreatedAtAscParams) ([]Note, error)
+	ListNotesByCreatedAtDesc(ctx context.Context, arg ListNotesByCreatedAtDescParams) ([]Note, error)
+	ListNotesByTitleAsc(ctx context.Context, arg ListNotesByTitleAscParams) ([]Note, error)
+	ListNotesByTitleDesc(ctx context.Context, arg ListNotesByTitleDescParams) ([]Note, error)
+	ListNotesByUpdatedAtAsc(ctx context.Context, arg ListNotesByUpdatedAtAscParams) ([]Note, error)
+	ListNotesByUpdatedAtDesc(ctx context.Context, arg ListNotesByUpdatedAtDescParams) ([]Note, error)
+	ListOrphanedAttachments(ctx context.Context, limit int32) ([]Attachment, error)
+	ListPresence(ctx context.Context, noteID int64) ([]NotePresence, error)
+	ListSessionPresence(ctx context.Context, sessionID pgtype.UUID) ([]NotePresence, error)
+	ListTags(ctx context.Context, userID int64) ([]ListTagsRow, error)
+	ListTopNotes(ctx context.Context, arg ListTopNotesParams) ([]ListTopNotesRow, error)
+	ListTrashedNotes(ctx context.Context, arg ListTrashedNotesParams) ([]Note, error)
+	// A NULL user_id matches webhooks of any user.
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, userID int64) ([]Webhook, error)
+	// Held until the commit so ids commit in order for the chat relay.
+	LockChatMessages(ctx context.Context, pgAdvisoryXactLock int64) error
+	LockMetricsBatch(ctx context.Context, arg LockMetricsBatchParams) error
+	LockNoteDocument(ctx context.Context, noteID int64) (NoteDocument, error)
+	// Held until the commit so ids commit in order for the edit relay.
+	LockNoteEdits(ctx context.Context, pgAdvisoryXactLock int64) error
+	// Held until the commit so sequences commit in order for the log readers.
+	LockNoteEvents(ctx context.Context, pgAdvisoryXactLock int64) error
+	LockNotePresence(ctx context.Context, noteID int64) error
+	LockWebhookCursor(ctx context.Context) (int64, error)
+	MergeTags(ctx context.Context, arg MergeTagsParams) (int64, error)
+	MoveNote(ctx context.Context, arg MoveNoteParams) (Note, error)
+	MoveNotebook(ctx context.Context, arg MoveNotebookParams) (Notebook, error)
+	NotifyChatMessage(ctx context.Context, arg NotifyChatMessageParams) error
+	NotifyNoteEdit(ctx context.Context, arg NotifyNoteEditParams) error
+	NotifyNoteEvent(ctx context.Context, arg NotifyNoteEventParams) error
+	// Events of the purged notes are scrubbed as in PurgeNote.
+	PurgeDeletedNotes(ctx context.Context, arg PurgeDeletedNotesParams) (int64, error)
+	PurgeMetricRecords(ctx context.Context, receivedAt pgtype.Timestamptz) (int64, error)
+	// Events of the purged note are scrubbed down to the note and owner ids,
+	// the title and content do not outlive the note in the event log.
+	PurgeNote(ctx context.Context, id int64) (int64, error)
+	PurgeNoteEdits(ctx context.Context, createdBefore pgtype.Timestamptz) (int64, error)
+	// Events the webhook dispatcher has not reached yet are kept.
+	PurgeNoteEvents(ctx context.Context, arg PurgeNoteEventsParams) (int64, error)
+	// The attempts counter fences the lease, the attempt is not recorded when
+	// the delivery has been claimed again meanwhile.
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (int64, error)
+	// A NULL user_id matches webhooks of any user.
+	RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (WebhookDelivery, error)
+	RefreshPresence(ctx context.Context, arg RefreshPresenceParams) (int64, error)
+	RenameNotebook(ctx context.Context, arg RenameNotebookParams) (Notebook, error)
+	RenameTag(ctx context.Context, arg RenameTagParams) (RenameTagRow, error)
+	RestoreNote(ctx context.Context, id int64) (Note, error)
+	RevokeShareLink(ctx context.Context, arg RevokeShareLinkParams) (int64, error)
+	RewriteNotebookPaths(ctx context.Context, arg RewriteNotebookPathsParams) (int64, error)
+	// Matched terms in the snippet are wrapped in U+E000 and U+E001, the repository
+	// turns them into offsets, so the markers are stripped from the text first.
+	SearchNotes(ctx context.Context, arg SearchNotesParams) ([]SearchNotesRow, error)
+	UpdateNote(ctx context.Context, arg UpdateNoteParams) (Note, error)
+	UpdateNoteDocument(ctx context.Context, arg UpdateNoteDocumentParams) error
+	UpdateWebhookCursor(ctx context.Context, sequence int64) error
+	UpsertCollaborator(ctx context.Context, arg UpsertCollaboratorParams) (NoteCollaborator, error)
+	UpsertTags(ctx context.Context, arg UpsertTagsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
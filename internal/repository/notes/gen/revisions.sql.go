@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: revisions.sql
+
+package notesrepo
+
+import (
+	"context"
+)
+
+const createNoteRevision = `-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, revision, author_id, title, content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING note_id, revision, author_id, title, content, created_at
+`
+
+type CreateNoteRevisionParams struct {
+	NoteID   int64
+	Revision int64
+	AuthorID int64
+	Title    string
+	Content  string
+}
+
+func (q *Queries) CreateNoteRevision(ctx context.Context, arg CreateNoteRevisionParams) (NoteRevision, error) {
+	row := q.db.QueryRow(ctx, createNoteRevision,
+		arg.NoteID,
+		arg.Revision,
+		arg.AuthorID,
+		arg.Title,
+		arg.Content,
+	)
+	var i NoteRevision
+	err := row.Scan(
+		&i.NoteID,
+		&i.Revision,
+		&i.AuthorID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getNoteRevision = `-- name: GetNoteRevision :one
+SELECT note_id, revision, author_id, title, content, created_at
+FROM note_revisions
+WHERE note_id = $1
+  AND revision = $2
+`
+
+type GetNoteRevisionParams struct {
+	NoteID   int64
+	Revision int64
+}
+
+func (q *Queries) GetNoteRevision(ctx context.Context, arg GetNoteRevisionParams) (NoteRevision, error) {
+	row := q.db.QueryRow(ctx, getNoteRevision, arg.NoteID, arg.Revision)
+	var i NoteRevision
+	err := row.Scan(
+		&i.NoteID,
+		&i.Revision,
+		&i.AuthorID,
+		&i.Title,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listNoteRevisions = `-- name: ListNoteRevisions :many
+SELECT note_id, revision, author_id, title, content, created_at
+FROM note_revisions
+WHERE note_id = $1
+  AND ($2::bigint IS NULL OR revision < $2)
+ORDER BY revision DESC
+LIMIT $3
+`
+
+type ListNoteRevisionsParams struct {
+	NoteID         int64
+	BeforeRevision *int64
+	PageSize       int32
+}
+
+func (q *Queries) ListNoteRevisions(ctx context.Context, arg ListNoteRevisionsParams) ([]NoteRevision, error) {
+	rows, err := q.db.Query(ctx, listNoteRevisions, arg.NoteID, arg.BeforeRevision, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []NoteRevision{}
+	for rows.Next() {
+		var i NoteRevision
+		if err := rows.Scan(
+			&i.NoteID,
+			&i.Revision,
+			&i.AuthorID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
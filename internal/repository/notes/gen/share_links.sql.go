@@ -0,0 +1,104 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: share_links.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createShareLink = `-- name: CreateShareLink :one
+INSERT INTO share_links (note_id, token_hash, password_hash, expires_at, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, note_id, token_hash, password_hash, expires_at, revoked_at, created_by, created_at
+`
+
+type CreateShareLinkParams struct {
+	NoteID       int64
+	TokenHash    []byte
+	PasswordHash *string
+	ExpiresAt    pgtype.Timestamptz
+	CreatedBy    int64
+}
+
+func (q *Queries) CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (ShareLink, error) {
+	row := q.db.QueryRow(ctx, createShareLink,
+		arg.NoteID,
+		arg.TokenHash,
+		arg.PasswordHash,
+		arg.ExpiresAt,
+		arg.CreatedBy,
+	)
+	var i ShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.NoteID,
+		&i.TokenHash,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSharedNote = `-- name: GetSharedNote :one
+SELECT notes.id, notes.user_id, notes.title, notes.content, notes.created_at, notes.updated_at, notes.revision, notes.deleted_at, notes.notebook_id, share_links.password_hash, share_links.expires_at
+FROM share_links
+         JOIN notes ON notes.id = share_links.note_id
+WHERE share_links.token_hash = $1
+  AND share_links.revoked_at IS NULL
+  AND (share_links.expires_at IS NULL OR share_links.expires_at > now())
+  AND notes.deleted_at IS NULL
+`
+
+type GetSharedNoteRow struct {
+	Note         Note
+	PasswordHash *string
+	ExpiresAt    pgtype.Timestamptz
+}
+
+func (q *Queries) GetSharedNote(ctx context.Context, tokenHash []byte) (GetSharedNoteRow, error) {
+	row := q.db.QueryRow(ctx, getSharedNote, tokenHash)
+	var i GetSharedNoteRow
+	err := row.Scan(
+		&i.Note.ID,
+		&i.Note.UserID,
+		&i.Note.Title,
+		&i.Note.Content,
+		&i.Note.CreatedAt,
+		&i.Note.UpdatedAt,
+		&i.Note.Revision,
+		&i.Note.DeletedAt,
+		&i.Note.NotebookID,
+		&i.PasswordHash,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const revokeShareLink = `-- name: RevokeShareLink :execrows
+UPDATE share_links
+SET revoked_at = now()
+WHERE id = $1
+  AND note_id = $2
+  AND revoked_at IS NULL
+`
+
+type RevokeShareLinkParams struct {
+	ID     int64
+	NoteID int64
+}
+
+func (q *Queries) RevokeShareLink(ctx context.Context, arg RevokeShareLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeShareLink, arg.ID, arg.NoteID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,229 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: tags.sql
+
+package notesrepo
+
+import (
+	"context"
+)
+
+const addNoteTags = `-- name: AddNoteTags :exec
+INSERT INTO note_tags (note_id, tag_id)
+SELECT $1, id
+FROM tags
+WHERE user_id = $2
+  AND name = ANY ($3::text[])
+ON CONFLICT DO NOTHING
+`
+
+type AddNoteTagsParams struct {
+	NoteID int64
+	UserID int64
+	Names  []string
+}
+
+func (q *Queries) AddNoteTags(ctx context.Context, arg AddNoteTagsParams) error {
+	_, err := q.db.Exec(ctx, addNoteTags, arg.NoteID, arg.UserID, arg.Names)
+	return err
+}
+
+const deleteNoteTags = `-- name: DeleteNoteTags :exec
+DELETE
+FROM note_tags
+WHERE note_id = $1
+`
+
+func (q *Queries) DeleteNoteTags(ctx context.Context, noteID int64) error {
+	_, err := q.db.Exec(ctx, deleteNoteTags, noteID)
+	return err
+}
+
+const deleteTags = `-- name: DeleteTags :execrows
+DELETE
+FROM tags
+WHERE user_id = $1
+  AND name = ANY ($2::text[])
+`
+
+type DeleteTagsParams struct {
+	UserID int64
+	Names  []string
+}
+
+func (q *Queries) DeleteTags(ctx context.Context, arg DeleteTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTags, arg.UserID, arg.Names)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getTagIDs = `-- name: GetTagIDs :many
+SELECT id
+FROM tags
+WHERE user_id = $1
+  AND name = ANY ($2::text[])
+`
+
+type GetTagIDsParams struct {
+	UserID int64
+	Names  []string
+}
+
+func (q *Queries) GetTagIDs(ctx context.Context, arg GetTagIDsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, getTagIDs, arg.UserID, arg.Names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNoteTags = `-- name: ListNoteTags :many
+SELECT nt.note_id, t.name
+FROM note_tags nt
+         JOIN tags t ON t.id = nt.tag_id
+WHERE nt.note_id = ANY ($1::bigint[])
+ORDER BY nt.note_id, t.name
+`
+
+type ListNoteTagsRow struct {
+	NoteID int64
+	Name   string
+}
+
+func (q *Queries) ListNoteTags(ctx context.Context, noteIds []int64) ([]ListNoteTagsRow, error) {
+	rows, err := q.db.Query(ctx, listNoteTags, noteIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListNoteTagsRow{}
+	for rows.Next() {
+		var i ListNoteTagsRow
+		if err := rows.Scan(&i.NoteID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT t.id, t.name, count(n.id) AS note_count
+FROM tags t
+         LEFT JOIN note_tags nt ON nt.tag_id = t.id
+         LEFT JOIN notes n ON n.id = nt.note_id AND n.deleted_at IS NULL
+WHERE t.user_id = $1
+GROUP BY t.id, t.name
+ORDER BY t.name
+`
+
+type ListTagsRow struct {
+	ID        int64
+	Name      string
+	NoteCount int64
+}
+
+func (q *Queries) ListTags(ctx context.Context, userID int64) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTagsRow{}
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.NoteCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeTags = `-- name: MergeTags :execrows
+INSERT INTO note_tags (note_id, tag_id)
+SELECT DISTINCT nt.note_id, target.id
+FROM note_tags nt
+         JOIN tags source ON source.id = nt.tag_id
+         JOIN tags target ON target.user_id = source.user_id AND target.name = $1
+WHERE source.user_id = $2
+  AND source.name = ANY ($3::text[])
+ON CONFLICT DO NOTHING
+`
+
+type MergeTagsParams struct {
+	Target  string
+	UserID  int64
+	Sources []string
+}
+
+func (q *Queries) MergeTags(ctx context.Context, arg MergeTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, mergeTags, arg.Target, arg.UserID, arg.Sources)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renameTag = `-- name: RenameTag :one
+UPDATE tags
+SET name = $1
+WHERE user_id = $2
+  AND name = $3
+RETURNING id, name
+`
+
+type RenameTagParams struct {
+	NewName string
+	UserID  int64
+	Name    string
+}
+
+type RenameTagRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) RenameTag(ctx context.Context, arg RenameTagParams) (RenameTagRow, error) {
+	row := q.db.QueryRow(ctx, renameTag, arg.NewName, arg.UserID, arg.Name)
+	var i RenameTagRow
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const upsertTags = `-- name: UpsertTags :exec
+INSERT INTO tags (user_id, name)
+SELECT $1, unnest($2::text[])
+ON CONFLICT (user_id, name) DO NOTHING
+`
+
+type UpsertTagsParams struct {
+	UserID int64
+	Names  []string
+}
+
+func (q *Queries) UpsertTags(ctx context.Context, arg UpsertTagsParams) error {
+	_, err := q.db.Exec(ctx, upsertTags, arg.UserID, arg.Names)
+	return err
+}
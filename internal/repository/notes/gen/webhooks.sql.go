@@ -0,0 +1,375 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: webhooks.sql
+
+package notesrepo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimWebhookDelivery = `-- name: ClaimWebhookDelivery :one
+WITH due AS (
+    SELECT due.id
+    FROM webhook_deliveries due
+    WHERE due.status = 'pending'
+      AND due.next_attempt_at <= now()
+    ORDER BY due.next_attempt_at, due.id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET attempts        = d.attempts + 1,
+    next_attempt_at = now() + make_interval(secs => $1::float8)
+FROM due, webhooks w
+WHERE d.id = due.id
+  AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_sequence, d.event_type, d.payload, d.status,
+    d.attempts, d.response_status, d.last_error, d.created_at, d.completed_at, d.next_attempt_at,
+    w.url, w.secret
+`
+
+type ClaimWebhookDeliveryRow struct {
+	ID             int64
+	WebhookID      int64
+	EventSequence  int64
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	ResponseStatus *int32
+	LastError      *string
+	CreatedAt      pgtype.Timestamptz
+	CompletedAt    pgtype.Timestamptz
+	NextAttemptAt  pgtype.Timestamptz
+	Url            string
+	Secret         string
+}
+
+// The claimed delivery is leased by moving its next attempt past the lease,
+// other dispatchers pick it up again only if the attempt is not recorded
+// until then.
+func (q *Queries) ClaimWebhookDelivery(ctx context.Context, leaseSeconds float64) (ClaimWebhookDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, claimWebhookDelivery, leaseSeconds)
+	var i ClaimWebhookDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventSequence,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.NextAttemptAt,
+		&i.Url,
+		&i.Secret,
+	)
+	return i, err
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks (user_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, url, secret, event_types, created_at
+`
+
+type CreateWebhookParams struct {
+	UserID     int64
+	Url        string
+	Secret     string
+	EventTypes []string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.UserID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteWebhookParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhook, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (webhook_id, event_sequence, event_type, payload)
+SELECT w.id, $1::bigint, $2::varchar, $3::jsonb
+FROM webhooks w
+WHERE w.user_id = ANY($4::bigint[])
+  AND ($2::varchar = ANY(w.event_types)
+    OR cardinality(w.event_types) = 0 AND $2::varchar NOT IN ('presence_joined', 'presence_left'))
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventSequence int64
+	EventType     string
+	Payload       []byte
+	Recipients    []int64
+}
+
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueWebhookDeliveries,
+		arg.EventSequence,
+		arg.EventType,
+		arg.Payload,
+		arg.Recipients,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWebhook = `-- name: GetWebhook :one
+SELECT id, user_id, url, secret, event_types, created_at
+FROM webhooks
+WHERE id = $1
+  AND ($2::bigint IS NULL OR user_id = $2)
+`
+
+type GetWebhookParams struct {
+	ID     int64
+	UserID *int64
+}
+
+// A NULL user_id matches webhooks of any user.
+func (q *Queries) GetWebhook(ctx context.Context, arg GetWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, getWebhook, arg.ID, arg.UserID)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const initWebhookCursor = `-- name: InitWebhookCursor :exec
+INSERT INTO webhook_cursor (sequence)
+SELECT COALESCE(MAX(sequence), 0) FROM note_events
+ON CONFLICT (id) DO NOTHING
+`
+
+func (q *Queries) InitWebhookCursor(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, initWebhookCursor)
+	return err
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT d.id, d.webhook_id, d.event_sequence, d.event_type, d.payload, d.status,
+       d.attempts, d.response_status, d.last_error, d.created_at, d.completed_at, d.next_attempt_at
+FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.webhook_id = $1
+  AND ($2::bigint IS NULL OR w.user_id = $2)
+ORDER BY d.id DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID int64
+	UserID    *int64
+	PageSize  int32
+}
+
+// A NULL user_id matches webhooks of any user.
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, listWebhookDeliveries, arg.WebhookID, arg.UserID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventSequence,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.CompletedAt,
+			&i.NextAttemptAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooks = `-- name: ListWebhooks :many
+SELECT id, user_id, url, secret, event_types, created_at
+FROM webhooks
+WHERE user_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWebhooks(ctx context.Context, userID int64) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, listWebhooks, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Webhook{}
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockWebhookCursor = `-- name: LockWebhookCursor :one
+SELECT sequence FROM webhook_cursor
+FOR UPDATE
+`
+
+func (q *Queries) LockWebhookCursor(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, lockWebhookCursor)
+	var sequence int64
+	err := row.Scan(&sequence)
+	return sequence, err
+}
+
+const recordWebhookDeliveryAttempt = `-- name: RecordWebhookDeliveryAttempt :execrows
+UPDATE webhook_deliveries
+SET status          = $1,
+    response_status = $2,
+    last_error      = $3,
+    next_attempt_at = now() + make_interval(secs => $4::float8),
+    completed_at    = CASE WHEN $1::varchar <> 'pending' THEN now() END
+WHERE id = $5
+  AND attempts = $6
+  AND status = 'pending'
+`
+
+type RecordWebhookDeliveryAttemptParams struct {
+	Status            string
+	ResponseStatus    *int32
+	LastError         *string
+	RetryAfterSeconds float64
+	ID                int64
+	Attempts          int32
+}
+
+// The attempts counter fences the lease, the attempt is not recorded when
+// the delivery has been claimed again meanwhile.
+func (q *Queries) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (int64, error) {
+	result, err := q.db.Exec(ctx, recordWebhookDeliveryAttempt,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.RetryAfterSeconds,
+		arg.ID,
+		arg.Attempts,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const redeliverWebhookDelivery = `-- name: RedeliverWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook_id, event_sequence, event_type, payload)
+SELECT d.webhook_id, d.event_sequence, d.event_type, d.payload
+FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.id = $1
+  AND d.webhook_id = $2
+  AND ($3::bigint IS NULL OR w.user_id = $3)
+RETURNING id, webhook_id, event_sequence, event_type, payload, status,
+    attempts, response_status, last_error, created_at, completed_at, next_attempt_at
+`
+
+type RedeliverWebhookDeliveryParams struct {
+	ID        int64
+	WebhookID int64
+	UserID    *int64
+}
+
+// A NULL user_id matches webhooks of any user.
+func (q *Queries) RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, redeliverWebhookDelivery, arg.ID, arg.WebhookID, arg.UserID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventSequence,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.NextAttemptAt,
+	)
+	return i, err
+}
+
+const updateWebhookCursor = `-- name: UpdateWebhookCursor :exec
+UPDATE webhook_cursor SET sequence = $1
+`
+
+func (q *Queries) UpdateWebhookCursor(ctx context.Context, sequence int64) error {
+	_, err := q.db.Exec(ctx, updateWebhookCursor, sequence)
+	return err
+}
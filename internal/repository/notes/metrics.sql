@@ -0,0 +1,55 @@
+-- name: AddNoteViews :exec
+INSERT INTO note_view_stats (note_id, bucket, views)
+SELECT unnest(sqlc.arg('note_ids')::bigint[]),
+       unnest(sqlc.arg('buckets')::timestamptz[]),
+       unnest(sqlc.arg('views')::bigint[])
+ON CONFLICT (note_id, bucket) DO UPDATE SET views = note_view_stats.views + excluded.views;
+
+-- name: GetNoteViewStats :many
+SELECT s.bucket::timestamptz AS bucket, COALESCE(SUM(v.views), 0)::bigint AS views
+FROM generate_series(
+         sqlc.arg('from_bucket')::timestamptz,
+         sqlc.arg('last_bucket')::timestamptz,
+         sqlc.arg('step_seconds')::bigint * interval '1 second'
+     ) AS s(bucket)
+         LEFT JOIN note_view_stats v
+                   ON v.note_id = sqlc.arg('note_id')
+                       AND v.bucket >= s.bucket
+                       AND v.bucket < s.bucket + sqlc.arg('step_seconds')::bigint * interval '1 second'
+GROUP BY s.bucket
+ORDER BY s.bucket;
+
+-- name: ListTopNotes :many
+SELECT n.id, n.user_id, n.title, n.content, n.created_at, n.updated_at, n.revision, n.notebook_id,
+       SUM(v.views)::bigint AS views
+FROM note_view_stats v
+         JOIN notes n ON n.id = v.note_id
+WHERE v.bucket >= sqlc.arg('from_bucket')
+  AND v.bucket < sqlc.arg('to_bucket')
+  AND n.deleted_at IS NULL
+  AND (n.user_id = sqlc.arg('user_id') OR n.id IN (
+    SELECT note_id
+    FROM note_collaborators
+    WHERE collaborator_id = sqlc.arg('user_id')))
+GROUP BY n.id
+ORDER BY views DESC, n.id
+LIMIT sqlc.arg('page_size');
+
+-- name: LockMetricsBatch :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg('batch_id')::text, sqlc.arg('user_id')::bigint));
+
+-- name: ListMetricRecordSequences :many
+SELECT sequence
+FROM metric_records
+WHERE user_id = $1
+  AND batch_id = $2
+  AND sequence = ANY (sqlc.arg('sequences')::bigint[]);
+
+-- name: CopyMetricRecords :copyfrom
+INSERT INTO metric_records (user_id, batch_id, sequence, note_id, bucket, views)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: PurgeMetricRecords :execrows
+DELETE
+FROM metric_records
+WHERE received_at < $1;
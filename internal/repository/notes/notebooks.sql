@@ -0,0 +1,63 @@
+-- name: CreateNotebook :one
+WITH new_notebook AS (
+    SELECT nextval(pg_get_serial_sequence('notebooks', 'id')) AS id
+)
+INSERT INTO notebooks (id, user_id, parent_id, name, path)
+SELECT new_notebook.id,
+       sqlc.arg('user_id'),
+       sqlc.narg('parent_id'),
+       sqlc.arg('name'),
+       sqlc.arg('parent_path')::text || new_notebook.id || '/'
+FROM new_notebook
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at;
+
+-- name: GetNotebook :one
+SELECT id, user_id, parent_id, name, path, created_at, updated_at
+FROM notebooks
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: ListChildNotebooks :many
+SELECT id, user_id, parent_id, name, path, created_at, updated_at
+FROM notebooks
+WHERE user_id = sqlc.arg('user_id')
+  AND parent_id IS NOT DISTINCT FROM sqlc.narg('parent_id')
+ORDER BY name, id;
+
+-- name: ListNotebookSubtree :many
+SELECT nb.id, nb.user_id, nb.parent_id, nb.name, nb.path, nb.created_at, nb.updated_at,
+       count(n.id) AS note_count
+FROM notebooks nb
+         LEFT JOIN notes n ON n.notebook_id = nb.id AND n.deleted_at IS NULL
+WHERE nb.user_id = sqlc.arg('user_id')
+  AND nb.path LIKE sqlc.arg('path_prefix')::text || '%'
+GROUP BY nb.id
+ORDER BY nb.path;
+
+-- name: RenameNotebook :one
+UPDATE notebooks
+SET name       = sqlc.arg('name'),
+    updated_at = now()
+WHERE id = sqlc.arg('id')
+  AND user_id = sqlc.arg('user_id')
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at;
+
+-- name: MoveNotebook :one
+UPDATE notebooks
+SET parent_id  = sqlc.narg('parent_id'),
+    updated_at = now()
+WHERE id = sqlc.arg('id')
+  AND user_id = sqlc.arg('user_id')
+RETURNING id, user_id, parent_id, name, path, created_at, updated_at;
+
+-- name: RewriteNotebookPaths :execrows
+UPDATE notebooks
+SET path = sqlc.arg('new_prefix')::text || substr(path, length(sqlc.arg('old_prefix')::text) + 1)
+WHERE user_id = sqlc.arg('user_id')
+  AND path LIKE sqlc.arg('old_prefix')::text || '%';
+
+-- name: DeleteNotebook :execrows
+DELETE
+FROM notebooks
+WHERE id = $1
+  AND user_id = $2;
@@ -1,18 +1,297 @@
 -- name: CreateNote :one
 INSERT INTO notes (user_id, title, content)
 VALUES ($1, $2, $3)
-RETURNING id, user_id, title, content, created_at, updated_at;
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id;
 
 -- name: GetNote :one
-SELECT id, user_id, title, content, created_at, updated_at
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 FROM notes
-WHERE id = $1;
+WHERE id = $1
+  AND deleted_at IS NULL;
 
--- name: GetNotesByUserID :many
-SELECT id, user_id, title, content, created_at, updated_at
+-- name: ListNotesByCreatedAtAsc :many
+-- Own and shared notes are read by separate branches of the union, so
+-- the own notes branch walks idx_notes_user_id_created_at from the keyset,
+-- the other ListNotesBy queries differ only in the keyset column and order.
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (created_at, id) > (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::bigint))
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListNotesByCreatedAtDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::bigint))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListNotesByUpdatedAtAsc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (updated_at, id) > (sqlc.narg('after_updated_at')::timestamptz, sqlc.narg('after_id')::bigint))
+ORDER BY updated_at ASC, id ASC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListNotesByUpdatedAtDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (updated_at, id) < (sqlc.narg('after_updated_at')::timestamptz, sqlc.narg('after_id')::bigint))
+ORDER BY updated_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListNotesByTitleAsc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (title, id) > (sqlc.narg('after_title')::text, sqlc.narg('after_id')::bigint))
+ORDER BY title ASC, id ASC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListNotesByTitleDesc :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
+FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+      FROM notes own
+      WHERE own.user_id = sqlc.arg('user_id')
+      UNION ALL
+      SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+      FROM notes shared
+          JOIN note_collaborators c ON c.note_id = shared.id
+      WHERE c.collaborator_id = sqlc.arg('user_id')
+        AND shared.user_id <> sqlc.arg('user_id')) visible
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('updated_from')::timestamptz IS NULL OR updated_at >= sqlc.narg('updated_from'))
+  AND (sqlc.narg('updated_to')::timestamptz IS NULL OR updated_at < sqlc.narg('updated_to'))
+  AND (sqlc.narg('tag_ids')::bigint[] IS NULL OR id IN (
+    SELECT note_id
+    FROM note_tags
+    WHERE tag_id = ANY (sqlc.narg('tag_ids')::bigint[])
+    GROUP BY note_id
+    HAVING NOT sqlc.arg('match_all_tags')::bool
+        OR count(*) = cardinality(sqlc.narg('tag_ids')::bigint[])))
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (title, id) < (sqlc.narg('after_title')::text, sqlc.narg('after_id')::bigint))
+ORDER BY title DESC, id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: UpdateNote :one
+UPDATE notes
+SET title      = COALESCE(sqlc.narg('title'), title),
+    content    = COALESCE(sqlc.narg('content'), content),
+    updated_at = now(),
+    revision   = revision + 1
+WHERE id = sqlc.arg('id')
+  AND revision = sqlc.arg('revision')
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id;
+
+-- name: DeleteNote :one
+UPDATE notes
+SET deleted_at = now(),
+    revision   = revision + 1
+WHERE id = $1
+  AND revision = $2
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id;
+
+-- name: ListTrashedNotes :many
+SELECT id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id
 FROM notes
-WHERE user_id = $1
-ORDER BY created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND deleted_at IS NOT NULL
+  AND (sqlc.narg('after_id')::bigint IS NULL
+    OR (deleted_at, id) < (sqlc.narg('after_deleted_at')::timestamptz, sqlc.narg('after_id')::bigint))
+ORDER BY deleted_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: RestoreNote :one
+UPDATE notes
+SET deleted_at = NULL,
+    revision   = revision + 1
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id;
+
+-- name: PurgeNote :one
+-- Events of the purged note are scrubbed down to the note and owner ids,
+-- the title and content do not outlive the note in the event log.
+WITH purged AS (
+    DELETE FROM notes
+    WHERE id = $1
+      AND deleted_at IS NOT NULL
+    RETURNING id
+), scrubbed AS (
+    UPDATE note_events e
+    SET payload = jsonb_set(e.payload, '{note}', jsonb_build_object('ID', e.note_id, 'UserID', e.payload -> 'note' -> 'UserID'))
+    FROM purged
+    WHERE e.note_id = purged.id
+)
+SELECT count(*) FROM purged;
+
+-- name: PurgeDeletedNotes :one
+-- Events of the purged notes are scrubbed as in PurgeNote.
+WITH purged AS (
+    DELETE FROM notes
+    WHERE id IN (
+        SELECT trashed.id
+        FROM notes trashed
+        WHERE trashed.deleted_at < sqlc.arg('deleted_before')
+        LIMIT sqlc.arg('batch_size')
+    )
+    RETURNING id
+), scrubbed AS (
+    UPDATE note_events e
+    SET payload = jsonb_set(e.payload, '{note}', jsonb_build_object('ID', e.note_id, 'UserID', e.payload -> 'note' -> 'UserID'))
+    FROM purged
+    WHERE e.note_id = purged.id
+)
+SELECT count(*) FROM purged;
+
+-- name: SearchNotes :many
+-- Matched terms in the snippet are wrapped in U+E000 and U+E001, the repository
+-- turns them into offsets, so the markers are stripped from the text first.
+WITH search AS (
+    SELECT websearch_to_tsquery((sqlc.arg('language')::text)::regconfig, sqlc.arg('query')::text) AS query
+),
+ranked AS (
+    SELECT n.id, n.user_id, n.title, n.content, n.created_at, n.updated_at, n.revision, n.notebook_id,
+           ts_rank_cd(notes_search_vector(n.title, n.content), search.query) AS rank
+    FROM (SELECT own.id, own.user_id, own.title, own.content, own.created_at, own.updated_at, own.revision, own.deleted_at, own.notebook_id
+          FROM notes own
+          WHERE own.user_id = sqlc.arg('user_id')
+          UNION ALL
+          SELECT shared.id, shared.user_id, shared.title, shared.content, shared.created_at, shared.updated_at, shared.revision, shared.deleted_at, shared.notebook_id
+          FROM notes shared
+              JOIN note_collaborators c ON c.note_id = shared.id
+          WHERE c.collaborator_id = sqlc.arg('user_id')
+            AND shared.user_id <> sqlc.arg('user_id')) n, search
+    WHERE n.deleted_at IS NULL
+      AND notes_search_vector(n.title, n.content) @@ search.query
+)
+SELECT ranked.id, ranked.user_id, ranked.title, ranked.content, ranked.created_at, ranked.updated_at, ranked.revision,
+       ranked.notebook_id,
+       ranked.rank::real AS rank,
+       ts_headline((sqlc.arg('language')::text)::regconfig,
+                   translate(ranked.title || E'\n' || ranked.content, E'\uE000\uE001', ''), search.query,
+                   E'MaxFragments=2, MinWords=5, MaxWords=20, StartSel=\uE000, StopSel=\uE001')::text AS snippet
+FROM ranked, search
+WHERE sqlc.narg('after_id')::bigint IS NULL
+   OR (ranked.rank, ranked.id) < (sqlc.narg('after_rank')::real, sqlc.narg('after_id')::bigint)
+ORDER BY ranked.rank DESC, ranked.id DESC
+LIMIT sqlc.arg('page_size');
 
--- name: DeleteNote :exec
-DELETE FROM notes WHERE id = $1;
+-- name: MoveNote :one
+UPDATE notes
+SET notebook_id = sqlc.narg('notebook_id'),
+    revision    = revision + 1,
+    updated_at  = now()
+WHERE id = sqlc.arg('id')
+  AND revision = sqlc.arg('revision')
+  AND deleted_at IS NULL
+RETURNING id, user_id, title, content, created_at, updated_at, revision, deleted_at, notebook_id;
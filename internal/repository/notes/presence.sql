@@ -0,0 +1,50 @@
+-- name: LockNotePresence :exec
+SELECT pg_advisory_xact_lock(hashtextextended('note_presence', sqlc.arg('note_id')::bigint));
+
+-- name: CreatePresence :one
+INSERT INTO note_presence (note_id, session_id, user_id, activity, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING note_id, session_id, user_id, activity, joined_at, expires_at;
+
+-- name: CountUserPresence :one
+SELECT count(*)
+FROM note_presence
+WHERE note_id = $1
+  AND user_id = $2
+  AND expires_at > now();
+
+-- name: RefreshPresence :execrows
+UPDATE note_presence
+SET expires_at = $2
+WHERE session_id = $1;
+
+-- name: ListSessionPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE session_id = $1
+ORDER BY note_id;
+
+-- name: ListExpiredPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE expires_at < now()
+ORDER BY note_id
+LIMIT $1;
+
+-- name: DeletePresence :exec
+DELETE FROM note_presence
+WHERE note_id = $1
+  AND session_id = $2;
+
+-- name: DeleteExpiredPresence :execrows
+DELETE FROM note_presence
+WHERE note_id = $1
+  AND session_id = $2
+  AND expires_at < now();
+
+-- name: ListPresence :many
+SELECT note_id, session_id, user_id, activity, joined_at, expires_at
+FROM note_presence
+WHERE note_id = $1
+  AND expires_at > now()
+ORDER BY joined_at;
@@ -0,0 +1,18 @@
+-- name: CreateNoteRevision :one
+INSERT INTO note_revisions (note_id, revision, author_id, title, content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING note_id, revision, author_id, title, content, created_at;
+
+-- name: GetNoteRevision :one
+SELECT note_id, revision, author_id, title, content, created_at
+FROM note_revisions
+WHERE note_id = $1
+  AND revision = $2;
+
+-- name: ListNoteRevisions :many
+SELECT note_id, revision, author_id, title, content, created_at
+FROM note_revisions
+WHERE note_id = sqlc.arg('note_id')
+  AND (sqlc.narg('before_revision')::bigint IS NULL OR revision < sqlc.narg('before_revision'))
+ORDER BY revision DESC
+LIMIT sqlc.arg('page_size');
@@ -0,0 +1,20 @@
+-- name: CreateShareLink :one
+INSERT INTO share_links (note_id, token_hash, password_hash, expires_at, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, note_id, token_hash, password_hash, expires_at, revoked_at, created_by, created_at;
+
+-- name: RevokeShareLink :execrows
+UPDATE share_links
+SET revoked_at = now()
+WHERE id = $1
+  AND note_id = $2
+  AND revoked_at IS NULL;
+
+-- name: GetSharedNote :one
+SELECT sqlc.embed(notes), share_links.password_hash, share_links.expires_at
+FROM share_links
+         JOIN notes ON notes.id = share_links.note_id
+WHERE share_links.token_hash = $1
+  AND share_links.revoked_at IS NULL
+  AND (share_links.expires_at IS NULL OR share_links.expires_at > now())
+  AND notes.deleted_at IS NULL;
@@ -0,0 +1,62 @@
+-- name: UpsertTags :exec
+INSERT INTO tags (user_id, name)
+SELECT sqlc.arg('user_id'), unnest(sqlc.arg('names')::text[])
+ON CONFLICT (user_id, name) DO NOTHING;
+
+-- name: DeleteNoteTags :exec
+DELETE
+FROM note_tags
+WHERE note_id = $1;
+
+-- name: AddNoteTags :exec
+INSERT INTO note_tags (note_id, tag_id)
+SELECT sqlc.arg('note_id'), id
+FROM tags
+WHERE user_id = sqlc.arg('user_id')
+  AND name = ANY (sqlc.arg('names')::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: ListNoteTags :many
+SELECT nt.note_id, t.name
+FROM note_tags nt
+         JOIN tags t ON t.id = nt.tag_id
+WHERE nt.note_id = ANY (sqlc.arg('note_ids')::bigint[])
+ORDER BY nt.note_id, t.name;
+
+-- name: GetTagIDs :many
+SELECT id
+FROM tags
+WHERE user_id = sqlc.arg('user_id')
+  AND name = ANY (sqlc.arg('names')::text[]);
+
+-- name: ListTags :many
+SELECT t.id, t.name, count(n.id) AS note_count
+FROM tags t
+         LEFT JOIN note_tags nt ON nt.tag_id = t.id
+         LEFT JOIN notes n ON n.id = nt.note_id AND n.deleted_at IS NULL
+WHERE t.user_id = $1
+GROUP BY t.id, t.name
+ORDER BY t.name;
+
+-- name: RenameTag :one
+UPDATE tags
+SET name = sqlc.arg('new_name')
+WHERE user_id = sqlc.arg('user_id')
+  AND name = sqlc.arg('name')
+RETURNING id, name;
+
+-- name: MergeTags :execrows
+INSERT INTO note_tags (note_id, tag_id)
+SELECT DISTINCT nt.note_id, target.id
+FROM note_tags nt
+         JOIN tags source ON source.id = nt.tag_id
+         JOIN tags target ON target.user_id = source.user_id AND target.name = sqlc.arg('target')
+WHERE source.user_id = sqlc.arg('user_id')
+  AND source.name = ANY (sqlc.arg('sources')::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTags :execrows
+DELETE
+FROM tags
+WHERE user_id = sqlc.arg('user_id')
+  AND name = ANY (sqlc.arg('names')::text[]);
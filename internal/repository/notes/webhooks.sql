@@ -0,0 +1,100 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (user_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, url, secret, event_types, created_at;
+
+-- name: ListWebhooks :many
+SELECT id, user_id, url, secret, event_types, created_at
+FROM webhooks
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: GetWebhook :one
+-- A NULL user_id matches webhooks of any user.
+SELECT id, user_id, url, secret, event_types, created_at
+FROM webhooks
+WHERE id = sqlc.arg('id')
+  AND (sqlc.narg('user_id')::bigint IS NULL OR user_id = sqlc.narg('user_id'));
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1 AND user_id = $2;
+
+-- name: InitWebhookCursor :exec
+INSERT INTO webhook_cursor (sequence)
+SELECT COALESCE(MAX(sequence), 0) FROM note_events
+ON CONFLICT (id) DO NOTHING;
+
+-- name: LockWebhookCursor :one
+SELECT sequence FROM webhook_cursor
+FOR UPDATE;
+
+-- name: UpdateWebhookCursor :exec
+UPDATE webhook_cursor SET sequence = $1;
+
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (webhook_id, event_sequence, event_type, payload)
+SELECT w.id, sqlc.arg('event_sequence')::bigint, sqlc.arg('event_type')::varchar, sqlc.arg('payload')::jsonb
+FROM webhooks w
+WHERE w.user_id = ANY(sqlc.arg('recipients')::bigint[])
+  AND (sqlc.arg('event_type')::varchar = ANY(w.event_types)
+    OR cardinality(w.event_types) = 0 AND sqlc.arg('event_type')::varchar NOT IN ('presence_joined', 'presence_left'));
+
+-- name: ClaimWebhookDelivery :one
+-- The claimed delivery is leased by moving its next attempt past the lease,
+-- other dispatchers pick it up again only if the attempt is not recorded
+-- until then.
+WITH due AS (
+    SELECT due.id
+    FROM webhook_deliveries due
+    WHERE due.status = 'pending'
+      AND due.next_attempt_at <= now()
+    ORDER BY due.next_attempt_at, due.id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET attempts        = d.attempts + 1,
+    next_attempt_at = now() + make_interval(secs => sqlc.arg('lease_seconds')::float8)
+FROM due, webhooks w
+WHERE d.id = due.id
+  AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_sequence, d.event_type, d.payload, d.status,
+    d.attempts, d.response_status, d.last_error, d.created_at, d.completed_at, d.next_attempt_at,
+    w.url, w.secret;
+
+-- name: RecordWebhookDeliveryAttempt :execrows
+-- The attempts counter fences the lease, the attempt is not recorded when
+-- the delivery has been claimed again meanwhile.
+UPDATE webhook_deliveries
+SET status          = sqlc.arg('status'),
+    response_status = sqlc.narg('response_status'),
+    last_error      = sqlc.narg('last_error'),
+    next_attempt_at = now() + make_interval(secs => sqlc.arg('retry_after_seconds')::float8),
+    completed_at    = CASE WHEN sqlc.arg('status')::varchar <> 'pending' THEN now() END
+WHERE id = sqlc.arg('id')
+  AND attempts = sqlc.arg('attempts')
+  AND status = 'pending';
+
+-- name: ListWebhookDeliveries :many
+-- A NULL user_id matches webhooks of any user.
+SELECT d.id, d.webhook_id, d.event_sequence, d.event_type, d.payload, d.status,
+       d.attempts, d.response_status, d.last_error, d.created_at, d.completed_at, d.next_attempt_at
+FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.webhook_id = sqlc.arg('webhook_id')
+  AND (sqlc.narg('user_id')::bigint IS NULL OR w.user_id = sqlc.narg('user_id'))
+ORDER BY d.id DESC
+LIMIT sqlc.arg('page_size');
+
+-- name: RedeliverWebhookDelivery :one
+-- A NULL user_id matches webhooks of any user.
+INSERT INTO webhook_deliveries (webhook_id, event_sequence, event_type, payload)
+SELECT d.webhook_id, d.event_sequence, d.event_type, d.payload
+FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.id = sqlc.arg('id')
+  AND d.webhook_id = sqlc.arg('webhook_id')
+  AND (sqlc.narg('user_id')::bigint IS NULL OR w.user_id = sqlc.narg('user_id'))
+RETURNING id, webhook_id, event_sequence, event_type, payload, status,
+    attempts, response_status, last_error, created_at, completed_at, next_attempt_at;
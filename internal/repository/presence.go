@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+// LockNotePresence serializes presence changes of the note until the end
+// of the transaction, so joins and leaves of a user are counted exactly.
+func (r *Repo) LockNotePresence(ctx context.Context, noteID int64) error {
+	if err := r.notesDB.LockNotePresence(ctx, noteID); err != nil {
+		return fmt.Errorf("lock note presence: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) CreatePresence(ctx context.Context, p entity.PresenceSession) (entity.PresenceSession, error) {
+	row, err := r.notesDB.CreatePresence(ctx, notesrepo.CreatePresenceParams{
+		NoteID:    p.NoteID,
+		SessionID: pgtype.UUID{Bytes: p.SessionID, Valid: true},
+		UserID:    p.UserID,
+		Activity:  p.Activity.String(),
+		ExpiresAt: converter.ConvertTimeToTimestampz(p.ExpiresAt),
+	})
+	if err != nil {
+		return entity.PresenceSession{}, fmt.Errorf("create presence: %v", err)
+	}
+
+	return presenceToEntity(row)
+}
+
+// CountUserPresence returns the number of unexpired sessions
+// of the user on the note.
+func (r *Repo) CountUserPresence(ctx context.Context, noteID, userID int64) (int64, error) {
+	count, err := r.notesDB.CountUserPresence(ctx, notesrepo.CountUserPresenceParams{
+		NoteID: noteID,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count user presence: %v", err)
+	}
+
+	return count, nil
+}
+
+// RefreshPresence moves the expiry of the session on every note,
+// it returns the number of refreshed notes.
+func (r *Repo) RefreshPresence(ctx context.Context, sessionID uuid.UUID, expiresAt time.Time) (int64, error) {
+	refreshed, err := r.notesDB.RefreshPresence(ctx, notesrepo.RefreshPresenceParams{
+		SessionID: pgtype.UUID{Bytes: sessionID, Valid: true},
+		ExpiresAt: converter.ConvertTimeToTimestampz(expiresAt),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("refresh presence: %v", err)
+	}
+
+	return refreshed, nil
+}
+
+// ListSessionPresence returns the notes of the session in note id order.
+func (r *Repo) ListSessionPresence(ctx context.Context, sessionID uuid.UUID) ([]entity.PresenceSession, error) {
+	rows, err := r.notesDB.ListSessionPresence(ctx, pgtype.UUID{Bytes: sessionID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list session presence: %v", err)
+	}
+
+	return presenceListToEntity(rows)
+}
+
+// ListExpiredPresence returns expired sessions in note id order.
+func (r *Repo) ListExpiredPresence(ctx context.Context, limit int) ([]entity.PresenceSession, error) {
+	rows, err := r.notesDB.ListExpiredPresence(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("list expired presence: %v", err)
+	}
+
+	return presenceListToEntity(rows)
+}
+
+func (r *Repo) DeletePresence(ctx context.Context, noteID int64, sessionID uuid.UUID) error {
+	if err := r.notesDB.DeletePresence(ctx, notesrepo.DeletePresenceParams{
+		NoteID:    noteID,
+		SessionID: pgtype.UUID{Bytes: sessionID, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("delete presence: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredPresence deletes the session unless it has been refreshed
+// meanwhile, it reports whether the session is deleted.
+func (r *Repo) DeleteExpiredPresence(ctx context.Context, noteID int64, sessionID uuid.UUID) (bool, error) {
+	deleted, err := r.notesDB.DeleteExpiredPresence(ctx, notesrepo.DeleteExpiredPresenceParams{
+		NoteID:    noteID,
+		SessionID: pgtype.UUID{Bytes: sessionID, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("delete expired presence: %v", err)
+	}
+
+	return deleted > 0, nil
+}
+
+// ListPresence returns unexpired sessions on the note, the earliest
+// joined first.
+func (r *Repo) ListPresence(ctx context.Context, noteID int64) ([]entity.PresenceSession, error) {
+	rows, err := r.notesDB.ListPresence(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("list presence: %v", err)
+	}
+
+	return presenceListToEntity(rows)
+}
+
+func presenceListToEntity(rows []notesrepo.NotePresence) ([]entity.PresenceSession, error) {
+	sessions := make([]entity.PresenceSession, 0, len(rows))
+	for _, row := range rows {
+		p, err := presenceToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, p)
+	}
+
+	return sessions, nil
+}
+
+func presenceToEntity(row notesrepo.NotePresence) (entity.PresenceSession, error) {
+	activity, err := entity.ParsePresenceActivity(row.Activity)
+	if err != nil {
+		return entity.PresenceSession{}, fmt.Errorf("presence of note %d: %w", row.NoteID, err)
+	}
+
+	return entity.PresenceSession{
+		NoteID:    row.NoteID,
+		SessionID: row.SessionID.Bytes,
+		UserID:    row.UserID,
+		Activity:  activity,
+		JoinedAt:  converter.ConvertTimestampzToTime(row.JoinedAt),
+		ExpiresAt: converter.ConvertTimestampzToTime(row.ExpiresAt),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+func (r *Repo) CreateNoteRevision(ctx context.Context, rev entity.NoteRevision) (entity.NoteRevision, error) {
+	row, err := r.notesDB.CreateNoteRevision(ctx, notesrepo.CreateNoteRevisionParams{
+		NoteID:   rev.NoteID,
+		Revision: rev.Revision,
+		AuthorID: rev.AuthorID,
+		Title:    rev.Title,
+		Content:  rev.Content,
+	})
+	if err != nil {
+		return entity.NoteRevision{}, fmt.Errorf("create note revision: %v", err)
+	}
+
+	return conv.ConvertNoteRevisionToEntity(row), nil
+}
+
+func (r *Repo) GetNoteRevision(ctx context.Context, noteID, revision int64) (entity.NoteRevision, error) {
+	row, err := r.notesDB.GetNoteRevision(ctx, notesrepo.GetNoteRevisionParams{
+		NoteID:   noteID,
+		Revision: revision,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NoteRevision{}, entity.ErrNoteRevisionNotFound
+		}
+		return entity.NoteRevision{}, fmt.Errorf("get note revision: %v", err)
+	}
+
+	return conv.ConvertNoteRevisionToEntity(row), nil
+}
+
+func (r *Repo) ListNoteRevisions(ctx context.Context, q entity.NoteRevisionsQuery) ([]entity.NoteRevision, error) {
+	params := notesrepo.ListNoteRevisionsParams{
+		NoteID:   q.NoteID,
+		PageSize: int32(q.PageSize),
+	}
+	if q.Before > 0 {
+		params.BeforeRevision = &q.Before
+	}
+
+	rows, err := r.notesDB.ListNoteRevisions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list note revisions: %v", err)
+	}
+
+	return conv.ConvertNoteRevisionsToEntity(rows), nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+func (r *Repo) CreateShareLink(ctx context.Context, link entity.ShareLink) (entity.ShareLink, error) {
+	params := notesrepo.CreateShareLinkParams{
+		NoteID:    link.NoteID,
+		TokenHash: link.TokenHash,
+		ExpiresAt: converter.ConvertTimeToTimestampz(link.ExpiresAt),
+		CreatedBy: link.CreatedBy,
+	}
+	if link.PasswordHash != "" {
+		params.PasswordHash = &link.PasswordHash
+	}
+
+	row, err := r.notesDB.CreateShareLink(ctx, params)
+	if err != nil {
+		return entity.ShareLink{}, fmt.Errorf("create share link: %v", err)
+	}
+
+	created := entity.ShareLink{
+		ID:        row.ID,
+		NoteID:    row.NoteID,
+		TokenHash: row.TokenHash,
+		ExpiresAt: converter.ConvertTimestampzToTime(row.ExpiresAt),
+		RevokedAt: converter.ConvertTimestampzToTime(row.RevokedAt),
+		CreatedBy: row.CreatedBy,
+		CreatedAt: converter.ConvertTimestampzToTime(row.CreatedAt),
+	}
+	if row.PasswordHash != nil {
+		created.PasswordHash = *row.PasswordHash
+	}
+
+	return created, nil
+}
+
+func (r *Repo) RevokeShareLink(ctx context.Context, noteID, linkID int64) error {
+	affected, err := r.notesDB.RevokeShareLink(ctx, notesrepo.RevokeShareLinkParams{
+		ID:     linkID,
+		NoteID: noteID,
+	})
+	if err != nil {
+		return fmt.Errorf("revoke share link: %v", err)
+	}
+
+	if affected == 0 {
+		return entity.ErrShareLinkNotFound
+	}
+
+	return nil
+}
+
+// GetSharedNote finds a note by an active, not expired share link.
+func (r *Repo) GetSharedNote(ctx context.Context, tokenHash []byte) (entity.SharedNote, error) {
+	row, err := r.notesDB.GetSharedNote(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.SharedNote{}, entity.ErrShareLinkNotFound
+		}
+		return entity.SharedNote{}, fmt.Errorf("get shared note: %v", err)
+	}
+
+	note, err := r.noteWithTags(ctx, conv.ConvertNoteToEntity(row.Note))
+	if err != nil {
+		return entity.SharedNote{}, err
+	}
+
+	shared := entity.SharedNote{
+		Note:      note,
+		ExpiresAt: converter.ConvertTimestampzToTime(row.ExpiresAt),
+	}
+	if row.PasswordHash != nil {
+		shared.PasswordHash = *row.PasswordHash
+	}
+
+	return shared, nil
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+const uniqueViolationCode = "23505"
+
+// SetNoteTags replaces the note tags, creating missing user tags on the way.
+// It should run in a transaction.
+func (r *Repo) SetNoteTags(ctx context.Context, userID, noteID int64, tags []string) error {
+	if err := r.notesDB.DeleteNoteTags(ctx, noteID); err != nil {
+		return fmt.Errorf("delete note tags: %v", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if err := r.notesDB.UpsertTags(ctx, notesrepo.UpsertTagsParams{
+		UserID: userID,
+		Names:  tags,
+	}); err != nil {
+		return fmt.Errorf("upsert tags: %v", err)
+	}
+
+	if err := r.notesDB.AddNoteTags(ctx, notesrepo.AddNoteTagsParams{
+		NoteID: noteID,
+		UserID: userID,
+		Names:  tags,
+	}); err != nil {
+		return fmt.Errorf("add note tags: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Repo) ListTags(ctx context.Context, userID int64) ([]entity.Tag, error) {
+	rows, err := r.notesDB.ListTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %v", err)
+	}
+
+	tags := make([]entity.Tag, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, entity.Tag{
+			ID:        row.ID,
+			Name:      row.Name,
+			NoteCount: row.NoteCount,
+		})
+	}
+
+	return tags, nil
+}
+
+func (r *Repo) RenameTag(ctx context.Context, userID int64, name, newName string) (entity.Tag, error) {
+	row, err := r.notesDB.RenameTag(ctx, notesrepo.RenameTagParams{
+		UserID:  userID,
+		Name:    name,
+		NewName: newName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Tag{}, entity.ErrTagNotFound
+		}
+		if isUniqueViolation(err) {
+			return entity.Tag{}, entity.ErrTagAlreadyExists
+		}
+		return entity.Tag{}, fmt.Errorf("rename tag: %v", err)
+	}
+
+	return entity.Tag{ID: row.ID, Name: row.Name}, nil
+}
+
+// MergeTags moves notes of the source tags to the target tag and drops the
+// sources. It returns how many source tags were dropped and should run in
+// a transaction.
+func (r *Repo) MergeTags(ctx context.Context, userID int64, sources []string, target string) (int64, error) {
+	if err := r.notesDB.UpsertTags(ctx, notesrepo.UpsertTagsParams{
+		UserID: userID,
+		Names:  []string{target},
+	}); err != nil {
+		return 0, fmt.Errorf("upsert target tag: %v", err)
+	}
+
+	if _, err := r.notesDB.MergeTags(ctx, notesrepo.MergeTagsParams{
+		UserID:  userID,
+		Sources: sources,
+		Target:  target,
+	}); err != nil {
+		return 0, fmt.Errorf("merge tags: %v", err)
+	}
+
+	deleted, err := r.notesDB.DeleteTags(ctx, notesrepo.DeleteTagsParams{
+		UserID: userID,
+		Names:  sources,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete merged tags: %v", err)
+	}
+
+	return deleted, nil
+}
+
+// tagIDs resolves tag names of the user, unknown names are skipped.
+func (r *Repo) tagIDs(ctx context.Context, userID int64, names []string) ([]int64, error) {
+	ids, err := r.notesDB.GetTagIDs(ctx, notesrepo.GetTagIDsParams{
+		UserID: userID,
+		Names:  names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get tag ids: %v", err)
+	}
+
+	return ids, nil
+}
+
+// attachTags loads tags of the notes with a single query.
+func (r *Repo) attachTags(ctx context.Context, notes []entity.Note) error {
+	if len(notes) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(notes))
+	for _, note := range notes {
+		ids = append(ids, note.ID)
+	}
+
+	rows, err := r.notesDB.ListNoteTags(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("list note tags: %v", err)
+	}
+
+	tags := make(map[int64][]string, len(notes))
+	for _, row := range rows {
+		tags[row.NoteID] = append(tags[row.NoteID], row.Name)
+	}
+
+	for i := range notes {
+		notes[i].Tags = tags[notes[i].ID]
+	}
+
+	return nil
+}
+
+func (r *Repo) noteWithTags(ctx context.Context, note entity.Note) (entity.Note, error) {
+	notes := []entity.Note{note}
+	if err := r.attachTags(ctx, notes); err != nil {
+		return entity.Note{}, err
+	}
+
+	return notes[0], nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
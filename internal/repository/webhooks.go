@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/internal/repository/converter"
+	notesrepo "github.com/evgeniy-krivenko/grpc-notes/internal/repository/notes/gen"
+)
+
+func (r *Repo) CreateWebhook(ctx context.Context, w entity.Webhook) (entity.Webhook, error) {
+	eventTypes := make([]string, 0, len(w.EventTypes))
+	for _, t := range w.EventTypes {
+		eventTypes = append(eventTypes, t.String())
+	}
+
+	row, err := r.notesDB.CreateWebhook(ctx, notesrepo.CreateWebhookParams{
+		UserID:     w.UserID,
+		Url:        w.URL,
+		Secret:     w.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("create webhook: %v", err)
+	}
+
+	return webhookToEntity(row)
+}
+
+// GetWebhook returns the webhook of the user, userID 0 matches webhooks
+// of any user.
+func (r *Repo) GetWebhook(ctx context.Context, userID, id int64) (entity.Webhook, error) {
+	row, err := r.notesDB.GetWebhook(ctx, notesrepo.GetWebhookParams{
+		ID:     id,
+		UserID: webhookOwner(userID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Webhook{}, entity.ErrWebhookNotFound
+		}
+		return entity.Webhook{}, fmt.Errorf("get webhook: %v", err)
+	}
+
+	return webhookToEntity(row)
+}
+
+func (r *Repo) ListWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error) {
+	rows, err := r.notesDB.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list webhooks: %v", err)
+	}
+
+	webhooks := make([]entity.Webhook, 0, len(rows))
+	for _, row := range rows {
+		w, err := webhookToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (r *Repo) DeleteWebhook(ctx context.Context, userID, id int64) error {
+	deleted, err := r.notesDB.DeleteWebhook(ctx, notesrepo.DeleteWebhookParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete webhook: %v", err)
+	}
+	if deleted == 0 {
+		return entity.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// LockWebhookCursor returns the sequence of the last note event turned into
+// webhook deliveries and locks it until the end of the transaction.
+// The cursor starts at the end of the event log, past events are not
+// delivered.
+func (r *Repo) LockWebhookCursor(ctx context.Context) (int64, error) {
+	if err := r.notesDB.InitWebhookCursor(ctx); err != nil {
+		return 0, fmt.Errorf("init webhook cursor: %v", err)
+	}
+
+	sequence, err := r.notesDB.LockWebhookCursor(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("lock webhook cursor: %v", err)
+	}
+
+	return sequence, nil
+}
+
+func (r *Repo) UpdateWebhookCursor(ctx context.Context, sequence int64) error {
+	if err := r.notesDB.UpdateWebhookCursor(ctx, sequence); err != nil {
+		return fmt.Errorf("update webhook cursor: %v", err)
+	}
+
+	return nil
+}
+
+// EnqueueWebhookDeliveries adds a pending delivery of the payload for every
+// webhook of the event recipients subscribed to the event type.
+func (r *Repo) EnqueueWebhookDeliveries(ctx context.Context, event entity.NoteEvent, payload []byte) (int64, error) {
+	enqueued, err := r.notesDB.EnqueueWebhookDeliveries(ctx, notesrepo.EnqueueWebhookDeliveriesParams{
+		EventSequence: event.Sequence,
+		EventType:     event.Type.String(),
+		Payload:       payload,
+		Recipients:    event.Recipients,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("enqueue webhook deliveries: %v", err)
+	}
+
+	return enqueued, nil
+}
+
+// ClaimWebhookDelivery returns the pending delivery due first with its
+// webhook, counts the attempt and leases the delivery: it is not due again
+// until the lease ends. It returns entity.ErrWebhookDeliveryNotFound when
+// there is nothing to deliver.
+func (r *Repo) ClaimWebhookDelivery(ctx context.Context, lease time.Duration) (entity.WebhookDelivery, entity.Webhook, error) {
+	row, err := r.notesDB.ClaimWebhookDelivery(ctx, lease.Seconds())
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.WebhookDelivery{}, entity.Webhook{}, entity.ErrWebhookDeliveryNotFound
+		}
+		return entity.WebhookDelivery{}, entity.Webhook{}, fmt.Errorf("claim webhook delivery: %v", err)
+	}
+
+	d, err := webhookDeliveryToEntity(notesrepo.WebhookDelivery{
+		ID:             row.ID,
+		WebhookID:      row.WebhookID,
+		EventSequence:  row.EventSequence,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       row.Attempts,
+		ResponseStatus: row.ResponseStatus,
+		LastError:      row.LastError,
+		CreatedAt:      row.CreatedAt,
+		CompletedAt:    row.CompletedAt,
+		NextAttemptAt:  row.NextAttemptAt,
+	})
+	if err != nil {
+		return entity.WebhookDelivery{}, entity.Webhook{}, err
+	}
+
+	return d, entity.Webhook{
+		ID:     row.WebhookID,
+		URL:    row.Url,
+		Secret: row.Secret,
+	}, nil
+}
+
+// RecordWebhookDeliveryAttempt saves the outcome of the claimed attempt,
+// a still pending delivery is due again after retryAfter. It returns
+// entity.ErrWebhookDeliveryNotFound when the delivery has been deleted
+// or claimed again since the attempt was claimed.
+func (r *Repo) RecordWebhookDeliveryAttempt(
+	ctx context.Context,
+	d entity.WebhookDelivery,
+	retryAfter time.Duration,
+) error {
+	params := notesrepo.RecordWebhookDeliveryAttemptParams{
+		ID:                d.ID,
+		Status:            d.Status.String(),
+		Attempts:          int32(d.Attempts),
+		RetryAfterSeconds: retryAfter.Seconds(),
+	}
+	if d.ResponseStatus != 0 {
+		responseStatus := int32(d.ResponseStatus)
+		params.ResponseStatus = &responseStatus
+	}
+	if d.LastError != "" {
+		params.LastError = &d.LastError
+	}
+
+	recorded, err := r.notesDB.RecordWebhookDeliveryAttempt(ctx, params)
+	if err != nil {
+		return fmt.Errorf("record webhook delivery attempt: %v", err)
+	}
+	if recorded == 0 {
+		return entity.ErrWebhookDeliveryNotFound
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of the webhook,
+// newest first. userID 0 matches webhooks of any user.
+func (r *Repo) ListWebhookDeliveries(ctx context.Context, userID, webhookID int64, limit int) ([]entity.WebhookDelivery, error) {
+	rows, err := r.notesDB.ListWebhookDeliveries(ctx, notesrepo.ListWebhookDeliveriesParams{
+		WebhookID: webhookID,
+		UserID:    webhookOwner(userID),
+		PageSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %v", err)
+	}
+
+	deliveries := make([]entity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		d, err := webhookDeliveryToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+// RedeliverWebhookDelivery enqueues a new pending delivery with the payload
+// of the given one. userID 0 matches webhooks of any user.
+func (r *Repo) RedeliverWebhookDelivery(ctx context.Context, userID, webhookID, id int64) (entity.WebhookDelivery, error) {
+	row, err := r.notesDB.RedeliverWebhookDelivery(ctx, notesrepo.RedeliverWebhookDeliveryParams{
+		ID:        id,
+		WebhookID: webhookID,
+		UserID:    webhookOwner(userID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.WebhookDelivery{}, entity.ErrWebhookDeliveryNotFound
+		}
+		return entity.WebhookDelivery{}, fmt.Errorf("redeliver webhook delivery: %v", err)
+	}
+
+	return webhookDeliveryToEntity(row)
+}
+
+// webhookOwner is the user_id argument of the webhook lookups,
+// NULL matches webhooks of any user.
+func webhookOwner(userID int64) *int64 {
+	if userID == 0 {
+		return nil
+	}
+
+	return &userID
+}
+
+func webhookToEntity(row notesrepo.Webhook) (entity.Webhook, error) {
+	eventTypes := make([]entity.NoteEventType, 0, len(row.EventTypes))
+	for _, s := range row.EventTypes {
+		t, err := entity.ParseNoteEventType(s)
+		if err != nil {
+			return entity.Webhook{}, fmt.Errorf("webhook %d: %w", row.ID, err)
+		}
+		eventTypes = append(eventTypes, t)
+	}
+
+	return entity.Webhook{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		URL:        row.Url,
+		Secret:     row.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  converter.ConvertTimestampzToTime(row.CreatedAt),
+	}, nil
+}
+
+func webhookDeliveryToEntity(row notesrepo.WebhookDelivery) (entity.WebhookDelivery, error) {
+	eventType, err := entity.ParseNoteEventType(row.EventType)
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("webhook delivery %d: %w", row.ID, err)
+	}
+
+	status, err := entity.ParseWebhookDeliveryStatus(row.Status)
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("webhook delivery %d: %w", row.ID, err)
+	}
+
+	d := entity.WebhookDelivery{
+		ID:            row.ID,
+		WebhookID:     row.WebhookID,
+		EventSequence: row.EventSequence,
+		EventType:     eventType,
+		Payload:       row.Payload,
+		Status:        status,
+		Attempts:      int(row.Attempts),
+		CreatedAt:     converter.ConvertTimestampzToTime(row.CreatedAt),
+		CompletedAt:   converter.ConvertTimestampzToTime(row.CompletedAt),
+		NextAttemptAt: converter.ConvertTimestampzToTime(row.NextAttemptAt),
+	}
+	if row.ResponseStatus != nil {
+		d.ResponseStatus = int(*row.ResponseStatus)
+	}
+	if row.LastError != nil {
+		d.LastError = *row.LastError
+	}
+
+	return d, nil
+}
@@ -0,0 +1,209 @@
+package notebooks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const rootPath = "/"
+
+type notebooksRepository interface {
+	CreateNotebook(ctx context.Context, userID int64, name string, parent *entity.Notebook) (entity.Notebook, error)
+	GetNotebook(ctx context.Context, userID, id int64) (entity.Notebook, error)
+	ListChildNotebooks(ctx context.Context, userID, parentID int64) ([]entity.Notebook, error)
+	ListNotebookSubtree(ctx context.Context, userID int64, pathPrefix string) ([]entity.NotebookTree, error)
+	RenameNotebook(ctx context.Context, userID, id int64, name string) (entity.Notebook, error)
+	MoveNotebook(ctx context.Context, nb entity.Notebook, parent *entity.Notebook) (entity.Notebook, error)
+	DeleteNotebook(ctx context.Context, userID, id int64) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.55.2 -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	repo notebooksRepository `option:"mandatory" validate:"required"`
+	tx   transactor          `option:"mandatory" validate:"required"`
+}
+
+type Usecase struct {
+	Options
+}
+
+func New(opts Options) (*Usecase, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate notebooks usecase options: %v", err)
+	}
+
+	return &Usecase{Options: opts}, nil
+}
+
+// CreateNotebook creates a notebook under parentID, 0 creates a root notebook.
+func (u *Usecase) CreateNotebook(ctx context.Context, userID, parentID int64, name string) (entity.Notebook, error) {
+	var nb entity.Notebook
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		parent, err := u.parent(ctx, userID, parentID)
+		if err != nil {
+			return err
+		}
+
+		nb, err = u.repo.CreateNotebook(ctx, userID, strings.TrimSpace(name), parent)
+		return err
+	})
+	if err != nil {
+		return entity.Notebook{}, fmt.Errorf("usecase create notebook: %w", err)
+	}
+
+	slogx.Info(ctx, "success to create notebook", slogx.UserId(userID))
+	return nb, nil
+}
+
+func (u *Usecase) GetNotebook(ctx context.Context, userID, id int64) (entity.Notebook, error) {
+	nb, err := u.repo.GetNotebook(ctx, userID, id)
+	if err != nil {
+		return entity.Notebook{}, fmt.Errorf("usecase get notebook: %w", err)
+	}
+
+	return nb, nil
+}
+
+// ListNotebooks returns direct children of parentID, 0 lists root notebooks.
+func (u *Usecase) ListNotebooks(ctx context.Context, userID, parentID int64) ([]entity.Notebook, error) {
+	notebooks, err := u.repo.ListChildNotebooks(ctx, userID, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list notebooks: %w", err)
+	}
+
+	return notebooks, nil
+}
+
+func (u *Usecase) UpdateNotebook(ctx context.Context, userID, id int64, upd entity.NotebookUpdate) (entity.Notebook, error) {
+	var nb entity.Notebook
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		nb, err = u.repo.GetNotebook(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+
+		if upd.Name != nil {
+			nb, err = u.repo.RenameNotebook(ctx, userID, id, strings.TrimSpace(*upd.Name))
+			if err != nil {
+				return err
+			}
+		}
+
+		if upd.ParentID != nil && *upd.ParentID != nb.ParentID {
+			parent, err := u.parent(ctx, userID, *upd.ParentID)
+			if err != nil {
+				return err
+			}
+
+			if parent != nil && strings.HasPrefix(parent.Path, nb.Path) {
+				return entity.ErrNotebookCycle
+			}
+
+			nb, err = u.repo.MoveNotebook(ctx, nb, parent)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Notebook{}, fmt.Errorf("usecase update notebook: %w", err)
+	}
+
+	return nb, nil
+}
+
+// DeleteNotebook removes the notebook with all sub-notebooks, their notes
+// are kept outside of any notebook.
+func (u *Usecase) DeleteNotebook(ctx context.Context, userID, id int64) error {
+	if err := u.repo.DeleteNotebook(ctx, userID, id); err != nil {
+		return fmt.Errorf("usecase delete notebook: %w", err)
+	}
+
+	return nil
+}
+
+// GetNotebookTree returns the notebook with its whole subtree, id 0 returns
+// all root notebooks of the user with their subtrees.
+func (u *Usecase) GetNotebookTree(ctx context.Context, userID, id int64) ([]entity.NotebookTree, error) {
+	prefix := rootPath
+	if id != 0 {
+		nb, err := u.repo.GetNotebook(ctx, userID, id)
+		if err != nil {
+			return nil, fmt.Errorf("usecase get notebook tree: %w", err)
+		}
+		prefix = nb.Path
+	}
+
+	nodes, err := u.repo.ListNotebookSubtree(ctx, userID, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("usecase get notebook tree: %w", err)
+	}
+
+	return buildTree(nodes, id), nil
+}
+
+// parent loads the parent notebook, id 0 stands for the root and gives nil.
+func (u *Usecase) parent(ctx context.Context, userID, id int64) (*entity.Notebook, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	parent, err := u.repo.GetNotebook(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get parent notebook: %w", err)
+	}
+
+	return &parent, nil
+}
+
+// buildTree nests flat subtree nodes under their parents and sums note
+// counts bottom-up. rootID 0 means the nodes form a forest of root notebooks.
+func buildTree(nodes []entity.NotebookTree, rootID int64) []entity.NotebookTree {
+	children := make(map[int64][]entity.NotebookTree, len(nodes))
+	var roots []entity.NotebookTree
+	for _, node := range nodes {
+		if node.Notebook.ID == rootID {
+			roots = append(roots, node)
+			continue
+		}
+		children[node.Notebook.ParentID] = append(children[node.Notebook.ParentID], node)
+	}
+	if rootID == 0 {
+		roots = children[0]
+	}
+
+	var attach func(list []entity.NotebookTree) []entity.NotebookTree
+	attach = func(list []entity.NotebookTree) []entity.NotebookTree {
+		slices.SortFunc(list, func(a, b entity.NotebookTree) int {
+			if c := strings.Compare(a.Notebook.Name, b.Notebook.Name); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.Notebook.ID, b.Notebook.ID)
+		})
+
+		for i := range list {
+			list[i].Children = attach(children[list[i].Notebook.ID])
+			list[i].TotalNoteCount = list[i].NoteCount
+			for _, child := range list[i].Children {
+				list[i].TotalNoteCount += child.TotalNoteCount
+			}
+		}
+
+		return list
+	}
+
+	return attach(roots)
+}
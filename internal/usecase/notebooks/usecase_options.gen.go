@@ -0,0 +1,51 @@
+// Code generated by options-gen v0.55.2. DO NOT EDIT.
+
+package notebooks
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	repo notebooksRepository,
+	tx transactor,
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.repo = repo
+	o.tx = tx
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("repo", _validate_Options_repo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("tx", _validate_Options_tx(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_repo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.repo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `repo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_tx(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.tx, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `tx` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package notes
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const (
+	attachmentKeySize           = 16
+	defaultAttachmentType       = "application/octet-stream"
+	orphanedAttachmentBatchSize = 100
+)
+
+type blobStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// UploadAttachment stores the content read from r as an attachment of
+// a.NoteID. a.Size, when set, must match the size of the content.
+func (u *Usecase) UploadAttachment(
+	ctx context.Context,
+	userID int64,
+	a entity.Attachment,
+	r io.Reader,
+) (entity.Attachment, error) {
+	if err := u.authorize(ctx, userID, a.NoteID, entity.NoteRoleEditor); err != nil {
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: %w", err)
+	}
+
+	if a.Size > u.maxAttachmentSize {
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: %w", entity.ErrAttachmentTooLarge)
+	}
+
+	key, err := newAttachmentKey()
+	if err != nil {
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: %w", err)
+	}
+
+	hash := sha256.New()
+	// One extra byte tells a content of exactly the max size from a larger one.
+	content := &io.LimitedReader{R: io.TeeReader(r, hash), N: u.maxAttachmentSize + 1}
+
+	size, err := u.blobs.Put(ctx, key, content)
+	if err != nil {
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: put blob: %w", err)
+	}
+
+	switch {
+	case size > u.maxAttachmentSize:
+		err = entity.ErrAttachmentTooLarge
+	case a.Size != 0 && size != a.Size:
+		err = entity.ErrAttachmentSizeMismatch
+	}
+	if err != nil {
+		u.deleteBlob(ctx, key)
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: %w", err)
+	}
+
+	a.StorageKey = key
+	a.Size = size
+	a.SHA256 = hash.Sum(nil)
+	a.CreatedBy = userID
+	if a.ContentType == "" {
+		a.ContentType = defaultAttachmentType
+	}
+
+	created, err := u.repo.CreateAttachment(ctx, a)
+	if err != nil {
+		u.deleteBlob(ctx, key)
+		return entity.Attachment{}, fmt.Errorf("usecase upload attachment: %w", err)
+	}
+
+	slogx.Info(ctx, "success to upload attachment", slogx.UserId(userID), slog.Int64("size", size))
+	return created, nil
+}
+
+// OpenAttachment returns the attachment with its content, the caller
+// must close the content.
+func (u *Usecase) OpenAttachment(
+	ctx context.Context,
+	userID, noteID, id int64,
+) (entity.Attachment, io.ReadCloser, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return entity.Attachment{}, nil, fmt.Errorf("usecase open attachment: %w", err)
+	}
+
+	a, err := u.repo.GetAttachment(ctx, noteID, id)
+	if err != nil {
+		return entity.Attachment{}, nil, fmt.Errorf("usecase open attachment: %w", err)
+	}
+
+	content, err := u.blobs.Open(ctx, a.StorageKey)
+	if err != nil {
+		return entity.Attachment{}, nil, fmt.Errorf("usecase open attachment: open blob: %w", err)
+	}
+
+	return a, content, nil
+}
+
+func (u *Usecase) ListAttachments(ctx context.Context, userID, noteID int64) ([]entity.Attachment, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return nil, fmt.Errorf("usecase list attachments: %w", err)
+	}
+
+	attachments, err := u.repo.ListAttachments(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// removeOrphanedAttachments deletes blobs and records of attachments
+// left behind by purged notes.
+func (u *Usecase) removeOrphanedAttachments(ctx context.Context) error {
+	for {
+		orphaned, err := u.repo.ListOrphanedAttachments(ctx, orphanedAttachmentBatchSize)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]int64, 0, len(orphaned))
+		for _, a := range orphaned {
+			if err := u.blobs.Delete(ctx, a.StorageKey); err != nil {
+				return fmt.Errorf("delete blob: %w", err)
+			}
+			ids = append(ids, a.ID)
+		}
+
+		if len(ids) > 0 {
+			if err := u.repo.DeleteAttachments(ctx, ids); err != nil {
+				return err
+			}
+		}
+
+		if len(orphaned) < orphanedAttachmentBatchSize {
+			return nil
+		}
+	}
+}
+
+func (u *Usecase) deleteBlob(ctx context.Context, key string) {
+	if err := u.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+		slogx.Error(ctx, "delete attachment blob", slogx.Err(err))
+	}
+}
+
+func newAttachmentKey() (string, error) {
+	b := make([]byte, attachmentKeySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate attachment key: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,100 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+// JoinChat adds the user to the room of the note. The room delivers messages
+// posted by every participant, including the user, until ctx is done or the
+// participant falls behind and is removed from the room.
+func (u *Usecase) JoinChat(ctx context.Context, userID, noteID int64) (entity.ChatRoom, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return nil, fmt.Errorf("usecase join chat: %w", err)
+	}
+
+	p := u.chatRooms.join(noteID, u.chatQueueSize)
+	go func() {
+		<-ctx.Done()
+		u.chatRooms.leave(p, nil)
+	}()
+
+	return chatRoom{p}, nil
+}
+
+// PostChatMessage saves the message, it is delivered to the room
+// by the chat relay of every server instance.
+func (u *Usecase) PostChatMessage(ctx context.Context, m entity.ChatMessage) (entity.ChatMessage, error) {
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, m.UserID, m.NoteID, entity.NoteRoleViewer); err != nil {
+			return err
+		}
+
+		var err error
+		m, err = u.repo.CreateChatMessage(ctx, m)
+		return err
+	})
+	if err != nil {
+		return entity.ChatMessage{}, fmt.Errorf("usecase post chat message: %w", err)
+	}
+
+	return m, nil
+}
+
+func (u *Usecase) ListChatMessages(ctx context.Context, q entity.ChatMessagesQuery) (entity.ChatMessagesPage, error) {
+	if err := u.authorize(ctx, q.UserID, q.NoteID, entity.NoteRoleViewer); err != nil {
+		return entity.ChatMessagesPage{}, fmt.Errorf("usecase list chat messages: %w", err)
+	}
+
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	q.PageSize = pageSize + 1
+
+	messages, err := u.repo.ListChatMessages(ctx, q)
+	if err != nil {
+		return entity.ChatMessagesPage{}, fmt.Errorf("usecase list chat messages: %w", err)
+	}
+
+	if len(messages) <= pageSize {
+		return entity.ChatMessagesPage{Messages: messages}, nil
+	}
+
+	messages = messages[:pageSize]
+
+	return entity.ChatMessagesPage{
+		Messages: messages,
+		Next:     messages[pageSize-1].ID,
+	}, nil
+}
+
+// RunChatRelay delivers chat messages saved by every server instance to
+// the rooms of this one until ctx is done.
+func (u *Usecase) RunChatRelay(ctx context.Context) error {
+	return relay[entity.ChatMessage]{
+		name:    "chat relay",
+		channel: u.chatChannel,
+		last:    u.repo.GetLastChatMessageID,
+		list:    u.repo.ListChatMessagesAfter,
+		id:      func(m entity.ChatMessage) int64 { return m.ID },
+		deliver: func(ctx context.Context, m entity.ChatMessage) {
+			u.chatRooms.publish(ctx, m.NoteID, m)
+		},
+	}.run(ctx, u.listener)
+}
+
+// chatRoom is a participant of the chat room of a note.
+type chatRoom struct {
+	*participant[entity.ChatMessage]
+}
+
+func (r chatRoom) Messages() <-chan entity.ChatMessage {
+	return r.items
+}
+
+func (r chatRoom) Err() error {
+	return r.err
+}
@@ -0,0 +1,118 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+// authorize checks that the user has at least the required role on the note,
+// trashed notes are not found.
+func (u *Usecase) authorize(ctx context.Context, userID, noteID int64, required entity.NoteRole) error {
+	return u.authorizeNote(ctx, userID, noteID, required, false)
+}
+
+// authorizeTrashed is authorize for the trash paths, the note may be trashed.
+func (u *Usecase) authorizeTrashed(ctx context.Context, userID, noteID int64, required entity.NoteRole) error {
+	return u.authorizeNote(ctx, userID, noteID, required, true)
+}
+
+func (u *Usecase) authorizeNote(
+	ctx context.Context,
+	userID, noteID int64,
+	required entity.NoteRole,
+	includeTrashed bool,
+) error {
+	role, err := u.repo.GetNoteRole(ctx, noteID, userID, includeTrashed)
+	if err != nil {
+		return err
+	}
+
+	if !role.Allows(required) {
+		return entity.ErrNoteAccessDenied
+	}
+
+	return nil
+}
+
+// ShareNote grants the collaborator a role on the note or changes
+// the granted one. Only note owners can share notes.
+func (u *Usecase) ShareNote(ctx context.Context, userID int64, c entity.Collaborator) (entity.Collaborator, error) {
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, userID, c.NoteID, entity.NoteRoleOwner); err != nil {
+			return err
+		}
+
+		note, err := u.repo.GetNote(ctx, c.NoteID)
+		if err != nil {
+			return err
+		}
+
+		if note.UserID == c.UserID {
+			return entity.ErrShareWithOwner
+		}
+
+		shared, err := u.repo.UpsertCollaborator(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		event, err = u.recordEvent(ctx, entity.NoteEvent{
+			Type:         entity.NoteEventShared,
+			Note:         note,
+			Collaborator: shared,
+		})
+		return err
+	})
+	if err != nil {
+		return entity.Collaborator{}, fmt.Errorf("usecase share note: %w", err)
+	}
+
+	slogx.Info(ctx, "success to share note", slogx.UserId(userID))
+	return event.Collaborator, nil
+}
+
+// UnshareNote revokes access of the collaborator. Owners can revoke anyone,
+// collaborators can only leave the note themselves.
+func (u *Usecase) UnshareNote(ctx context.Context, userID, noteID, collaboratorID int64) error {
+	if userID != collaboratorID {
+		if err := u.authorize(ctx, userID, noteID, entity.NoteRoleOwner); err != nil {
+			return fmt.Errorf("usecase unshare note: %w", err)
+		}
+	}
+
+	if err := u.repo.DeleteCollaborator(ctx, noteID, collaboratorID); err != nil {
+		return fmt.Errorf("usecase unshare note: %w", err)
+	}
+
+	return nil
+}
+
+// ListCollaborators returns the note owner followed by the collaborators.
+func (u *Usecase) ListCollaborators(ctx context.Context, userID, noteID int64) ([]entity.Collaborator, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return nil, fmt.Errorf("usecase list collaborators: %w", err)
+	}
+
+	note, err := u.repo.GetNote(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list collaborators: %w", err)
+	}
+
+	collaborators, err := u.repo.ListCollaborators(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list collaborators: %w", err)
+	}
+
+	owner := entity.Collaborator{
+		NoteID:    note.ID,
+		UserID:    note.UserID,
+		Role:      entity.NoteRoleOwner,
+		CreatedAt: note.CreatedAt,
+	}
+
+	return append([]entity.Collaborator{owner}, collaborators...), nil
+}
@@ -0,0 +1,454 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/ot"
+)
+
+const (
+	// editCursorTTL hides cursors of participants that have not moved them
+	// for long from joining participants, sessions of a crashed server never
+	// log their leave.
+	editCursorTTL = 30 * time.Minute
+
+	snapshotBatchSize = 100
+)
+
+// JoinEditSession returns the document of the note and streams edits of
+// the session. Operations of the participant itself are streamed as well, so
+// they are acknowledged in order with the operations of others. The stream
+// ends when ctx is done or the participant falls behind and leaves.
+func (u *Usecase) JoinEditSession(
+	ctx context.Context,
+	userID, noteID int64,
+) (entity.EditSnapshot, entity.NoteEditStream, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return entity.EditSnapshot{}, nil, fmt.Errorf("usecase join edit session: %w", err)
+	}
+
+	// Join the room before reading the document, so no edit committed after
+	// the read is missed. Edits committed before are skipped by version.
+	p := u.editRooms.join(noteID, u.editQueueSize)
+
+	snapshot, err := u.loadEditSnapshot(ctx, noteID)
+	if err != nil {
+		u.editRooms.leave(p, nil)
+		return entity.EditSnapshot{}, nil, fmt.Errorf("usecase join edit session: %w", err)
+	}
+
+	stream := &editStream{edits: make(chan entity.NoteEdit)}
+	go func() {
+		defer close(stream.edits)
+		defer u.leaveEditSession(context.WithoutCancel(ctx), snapshot.SessionID, userID, noteID)
+		defer u.editRooms.leave(p, nil)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case e, ok := <-p.items:
+				if !ok {
+					stream.err = p.err
+					return
+				}
+
+				if e.Kind == entity.NoteEditOperation && e.Version <= snapshot.Version {
+					continue
+				}
+				if e.Kind != entity.NoteEditOperation && e.SessionID == snapshot.SessionID {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case stream.edits <- e:
+				}
+			}
+		}
+	}()
+
+	return snapshot, stream, nil
+}
+
+func (u *Usecase) loadEditSnapshot(ctx context.Context, noteID int64) (entity.EditSnapshot, error) {
+	snapshot := entity.EditSnapshot{SessionID: uuid.New()}
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		doc, err := u.lockNoteDocument(ctx, noteID)
+		if err != nil {
+			return err
+		}
+
+		snapshot.Content = doc.Content
+		snapshot.Version = doc.Version
+
+		edits, err := u.repo.ListNoteEditCursors(ctx, noteID, time.Now().Add(-editCursorTTL))
+		if err != nil {
+			return err
+		}
+
+		snapshot.Cursors, err = u.moveCursors(ctx, doc, edits)
+		return err
+	})
+	if err != nil {
+		return entity.EditSnapshot{}, err
+	}
+
+	return snapshot, nil
+}
+
+// moveCursors moves cursors of sessions still in progress to the version of
+// the document.
+func (u *Usecase) moveCursors(ctx context.Context, doc entity.NoteDocument, edits []entity.NoteEdit) ([]entity.NoteEdit, error) {
+	cursors := make([]entity.NoteEdit, 0, len(edits))
+	oldest := doc.Version
+	for _, e := range edits {
+		if e.Kind == entity.NoteEditCursor {
+			cursors = append(cursors, e)
+			oldest = min(oldest, e.Version)
+		}
+	}
+
+	ops, err := u.repo.ListNoteEditOperations(ctx, doc.NoteID, oldest)
+	if err != nil {
+		return nil, err
+	}
+
+	length := utf8.RuneCountInString(doc.Content)
+	for i, c := range cursors {
+		for _, op := range ops {
+			if op.Version > c.Version {
+				c.Cursor = c.Cursor.Transform(op.Operation)
+			}
+		}
+
+		// operations of purged history cannot move the cursor
+		c.Cursor.Position = min(c.Cursor.Position, length)
+		c.Cursor.SelectionEnd = min(c.Cursor.SelectionEnd, length)
+		c.Version = doc.Version
+		cursors[i] = c
+	}
+
+	return cursors, nil
+}
+
+// ApplyEditOperation transforms the operation made on the e.Version of the
+// document against operations applied since and applies it. It returns
+// the applied operation with the version it produced.
+func (u *Usecase) ApplyEditOperation(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error) {
+	if err := e.Operation.Validate(); err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("usecase apply edit operation: %w: %v", entity.ErrInvalidEditOperation, err)
+	}
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, e.UserID, e.NoteID, entity.NoteRoleEditor); err != nil {
+			return err
+		}
+
+		doc, err := u.lockNoteDocument(ctx, e.NoteID)
+		if err != nil {
+			return err
+		}
+
+		ops, err := u.editsSince(ctx, doc, e.Version)
+		if err != nil {
+			return err
+		}
+
+		op := e.Operation
+		for _, applied := range ops {
+			if op, _, err = ot.Transform(op, applied.Operation); err != nil {
+				return fmt.Errorf("%w: %v", entity.ErrInvalidEditOperation, err)
+			}
+		}
+
+		content, err := ot.Apply(doc.Content, op)
+		if err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidEditOperation, err)
+		}
+
+		doc.Content = content
+		doc.Version++
+		doc.LastEditorID = e.UserID
+
+		if err := u.repo.UpdateNoteDocument(ctx, doc); err != nil {
+			return err
+		}
+
+		// The edit is appended last, it holds the edits lock until the commit.
+		e.Kind = entity.NoteEditOperation
+		e.Version = doc.Version
+		e.Operation = op
+		e, err = u.repo.AppendNoteEdit(ctx, e)
+		return err
+	})
+	if err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("usecase apply edit operation: %w", err)
+	}
+
+	return e, nil
+}
+
+// MoveEditCursor moves the cursor made on the e.Version of the document
+// to the current version and shares it with the session.
+func (u *Usecase) MoveEditCursor(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error) {
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, e.UserID, e.NoteID, entity.NoteRoleViewer); err != nil {
+			return err
+		}
+
+		doc, err := u.lockNoteDocument(ctx, e.NoteID)
+		if err != nil {
+			return err
+		}
+
+		ops, err := u.editsSince(ctx, doc, e.Version)
+		if err != nil {
+			return err
+		}
+
+		length := utf8.RuneCountInString(doc.Content)
+		for _, applied := range ops {
+			e.Cursor = e.Cursor.Transform(applied.Operation)
+		}
+		if e.Cursor.Position > length || e.Cursor.SelectionEnd > length {
+			return entity.ErrInvalidEditCursor
+		}
+
+		e.Kind = entity.NoteEditCursor
+		e.Version = doc.Version
+		e, err = u.repo.AppendNoteEdit(ctx, e)
+		return err
+	})
+	if err != nil {
+		return entity.NoteEdit{}, fmt.Errorf("usecase move edit cursor: %w", err)
+	}
+
+	return e, nil
+}
+
+func (u *Usecase) leaveEditSession(ctx context.Context, sessionID uuid.UUID, userID, noteID int64) {
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		_, err := u.repo.AppendNoteEdit(ctx, entity.NoteEdit{
+			NoteID:    noteID,
+			SessionID: sessionID,
+			UserID:    userID,
+			Kind:      entity.NoteEditLeave,
+		})
+		return err
+	})
+	if err != nil {
+		slogx.Error(ctx, "leave edit session", slogx.Err(err), slogx.UserId(userID))
+	}
+}
+
+// editsSince returns operations applied to the document after the version.
+func (u *Usecase) editsSince(ctx context.Context, doc entity.NoteDocument, version int64) ([]entity.NoteEdit, error) {
+	if version > doc.Version {
+		return nil, fmt.Errorf("%w: version %d is ahead of the document", entity.ErrInvalidEditOperation, version)
+	}
+	if version == doc.Version {
+		return nil, nil
+	}
+
+	ops, err := u.repo.ListNoteEditOperations(ctx, doc.NoteID, version)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(ops)) != doc.Version-version {
+		return nil, entity.ErrEditVersionGone
+	}
+
+	return ops, nil
+}
+
+// lockNoteDocument locks the document and catches it up with the note when
+// the note was updated outside of the session and the session has nothing
+// to save. Unsaved changes of the session overwrite such updates instead,
+// they are kept in the note revisions.
+func (u *Usecase) lockNoteDocument(ctx context.Context, noteID int64) (entity.NoteDocument, error) {
+	doc, err := u.repo.LockNoteDocument(ctx, noteID)
+	if err != nil {
+		return entity.NoteDocument{}, err
+	}
+	if doc.Dirty() {
+		return doc, nil
+	}
+
+	note, err := u.repo.GetNote(ctx, noteID)
+	if err != nil {
+		return entity.NoteDocument{}, err
+	}
+	if note.Revision == doc.NoteRevision {
+		return doc, nil
+	}
+
+	doc.NoteRevision = note.Revision
+	if op := ot.Replace(doc.Content, note.Content); !op.IsNoop() {
+		rev, err := u.repo.GetNoteRevision(ctx, noteID, note.Revision)
+		if err != nil {
+			return entity.NoteDocument{}, err
+		}
+
+		doc.Content = note.Content
+		doc.Version++
+		doc.SnapshotVersion = doc.Version
+
+		if _, err := u.repo.AppendNoteEdit(ctx, entity.NoteEdit{
+			NoteID:    noteID,
+			SessionID: uuid.Nil,
+			UserID:    rev.AuthorID,
+			Kind:      entity.NoteEditOperation,
+			Version:   doc.Version,
+			Operation: op,
+		}); err != nil {
+			return entity.NoteDocument{}, err
+		}
+	}
+
+	if err := u.repo.UpdateNoteDocument(ctx, doc); err != nil {
+		return entity.NoteDocument{}, err
+	}
+
+	return doc, nil
+}
+
+// RunEditSessions delivers edits saved by every server instance to the edit
+// sessions of this one and periodically saves changed documents to the notes
+// until ctx is done.
+func (u *Usecase) RunEditSessions(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		return relay[entity.NoteEdit]{
+			name:    "edit relay",
+			channel: u.editChannel,
+			last:    u.repo.GetLastNoteEditID,
+			list:    u.repo.ListNoteEditsAfter,
+			id:      func(e entity.NoteEdit) int64 { return e.ID },
+			deliver: func(ctx context.Context, e entity.NoteEdit) {
+				u.editRooms.publish(ctx, e.NoteID, e)
+			},
+		}.run(ctx, u.listener)
+	})
+
+	eg.Go(func() error {
+		ticker := time.NewTicker(u.snapshotInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+
+			u.saveNoteDocuments(ctx)
+		}
+	})
+
+	return eg.Wait()
+}
+
+// saveNoteDocuments saves a batch of changed documents, a document failing
+// to save does not hold back the others.
+func (u *Usecase) saveNoteDocuments(ctx context.Context) {
+	ids, err := u.repo.ListDirtyNoteDocuments(ctx, snapshotBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			slogx.Error(ctx, "list dirty note documents", slogx.Err(err))
+		}
+		return
+	}
+
+	for _, id := range ids {
+		if err := u.saveNoteDocument(ctx, id); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			slogx.Error(ctx, "save note document", slogx.Err(err), slog.Int64("note_id", id))
+		}
+	}
+}
+
+// saveNoteDocument updates the note content with the document, the revision
+// is authored by the last editor while they can still edit the note.
+func (u *Usecase) saveNoteDocument(ctx context.Context, noteID int64) error {
+	return u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		doc, err := u.repo.LockNoteDocument(ctx, noteID)
+		if err != nil {
+			return err
+		}
+		if !doc.Dirty() {
+			return nil
+		}
+
+		note, err := u.repo.GetNote(ctx, noteID)
+		if err != nil {
+			return err
+		}
+		if note.Revision != doc.NoteRevision {
+			slogx.Warn(ctx, "overwrite note updated outside of edit session",
+				slog.Int64("note_id", noteID),
+				slog.Int64("revision", note.Revision),
+			)
+		}
+
+		editorID := doc.LastEditorID
+		if err := u.authorize(ctx, editorID, noteID, entity.NoteRoleEditor); err != nil {
+			if !errors.Is(err, entity.ErrNoteAccessDenied) {
+				return err
+			}
+			editorID = note.UserID
+		}
+
+		event, err := u.updateNote(ctx, noteID, entity.NoteUpdate{
+			Revision: note.Revision,
+			EditorID: editorID,
+			Content:  &doc.Content,
+		})
+		if err != nil {
+			return err
+		}
+
+		doc.NoteRevision = event.Note.Revision
+		doc.SnapshotVersion = doc.Version
+
+		return u.repo.UpdateNoteDocument(ctx, doc)
+	})
+}
+
+func (u *Usecase) PurgeNoteEdits(ctx context.Context, createdBefore time.Time) (int64, error) {
+	purged, err := u.repo.PurgeNoteEdits(ctx, createdBefore)
+	if err != nil {
+		return 0, fmt.Errorf("usecase purge note edits: %w", err)
+	}
+
+	return purged, nil
+}
+
+type editStream struct {
+	edits chan entity.NoteEdit
+	err   error
+}
+
+func (s *editStream) Edits() <-chan entity.NoteEdit {
+	return s.edits
+}
+
+func (s *editStream) Err() error {
+	return s.err
+}
@@ -0,0 +1,182 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const replayPageSize = 500
+
+// SubscribeToEvents streams events of the notes owned by or shared with the user.
+// When resumeAfter is not 0, events logged after that sequence are replayed
+// first. The stream ends when ctx is done, the replay fails or the subscriber
+// falls behind and the broker disconnects it.
+func (u *Usecase) SubscribeToEvents(
+	ctx context.Context,
+	userID, resumeAfter int64,
+) (entity.NoteEventStream, error) {
+	stream := &eventStream{events: make(chan entity.NoteEvent)}
+	visible := func(event entity.NoteEvent) bool { return event.VisibleTo(userID) }
+
+	go func() {
+		defer close(stream.events)
+
+		replayed := resumeAfter
+		if resumeAfter > 0 {
+			var err error
+			// The bulk of missed events is replayed before subscribing,
+			// so a long replay cannot overflow the subscriber queue.
+			if replayed, err = u.replayEvents(ctx, userID, replayed, stream.events); err != nil {
+				stream.fail(ctx, userID, err)
+				return
+			}
+		}
+
+		sub := u.events.Subscribe(visible)
+		defer sub.Close()
+
+		if resumeAfter > 0 {
+			// Catch up with events committed before the subscription.
+			var err error
+			if replayed, err = u.replayEvents(ctx, userID, replayed, stream.events); err != nil {
+				stream.fail(ctx, userID, err)
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-sub.Events():
+				if !ok {
+					stream.err = sub.Err()
+					return
+				}
+
+				// Events up to the replayed sequence were committed before
+				// the replay read them, so they are already delivered.
+				if event.Sequence <= replayed {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case stream.events <- event:
+				}
+			}
+		}
+	}()
+
+	return stream, nil
+}
+
+// replayEvents sends logged events after the sequence and returns
+// the last sequence it has read.
+func (u *Usecase) replayEvents(
+	ctx context.Context,
+	userID, after int64,
+	result chan<- entity.NoteEvent,
+) (int64, error) {
+	last := after
+	for {
+		events, err := u.repo.ListNoteEvents(ctx, entity.NoteEventsQuery{
+			UserID:        userID,
+			AfterSequence: last,
+			PageSize:      replayPageSize,
+		})
+		if err != nil {
+			return last, fmt.Errorf("usecase replay events: %w", err)
+		}
+
+		for _, event := range events {
+			select {
+			case <-ctx.Done():
+				return last, ctx.Err()
+			case result <- event:
+			}
+			last = event.Sequence
+		}
+
+		if len(events) < replayPageSize {
+			return last, nil
+		}
+	}
+}
+
+// recordEvent appends the event for the note owner and collaborators
+// to the event log. Call it in the transaction of the change, subscribers
+// get the event from the bus once the transaction is committed. The returned
+// event has no sequence yet, it is assigned on commit.
+func (u *Usecase) recordEvent(ctx context.Context, event entity.NoteEvent) (entity.NoteEvent, error) {
+	collaborators, err := u.repo.ListCollaborators(ctx, event.Note.ID)
+	if err != nil {
+		return entity.NoteEvent{}, err
+	}
+
+	event.Recipients = make([]int64, 0, len(collaborators)+1)
+	event.Recipients = append(event.Recipients, event.Note.UserID)
+	for _, c := range collaborators {
+		event.Recipients = append(event.Recipients, c.UserID)
+	}
+
+	if err := u.repo.AppendNoteEvent(ctx, event); err != nil {
+		return entity.NoteEvent{}, err
+	}
+
+	return event, nil
+}
+
+// PurgeNoteEvents removes events logged before createdBefore, subscribers
+// cannot resume after them anymore. Events are removed in batches to keep
+// transactions short.
+func (u *Usecase) PurgeNoteEvents(ctx context.Context, createdBefore time.Time) (int64, error) {
+	var total int64
+	for {
+		purged, err := u.repo.PurgeNoteEvents(ctx, createdBefore, purgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("usecase purge note events: %w", err)
+		}
+
+		total += purged
+		if purged < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// RunEventBus delivers events committed by every server instance
+// to the subscribers of this one until ctx is done.
+func (u *Usecase) RunEventBus(ctx context.Context) error {
+	return u.bus.Run(ctx, func(event entity.NoteEvent) {
+		u.events.Publish(ctx, event)
+	})
+}
+
+type eventStream struct {
+	events chan entity.NoteEvent
+	err    error
+}
+
+func (s *eventStream) Events() <-chan entity.NoteEvent {
+	return s.events
+}
+
+func (s *eventStream) Err() error {
+	return s.err
+}
+
+func (s *eventStream) fail(ctx context.Context, userID int64, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	slogx.Error(ctx, "replay note events", slogx.Err(err), slogx.UserId(userID))
+	s.err = err
+}
@@ -0,0 +1,299 @@
+package notes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+const (
+	// maxStatsBuckets limits the range of GetNoteStats, about 41 days
+	// of hours or 2.7 years of days.
+	maxStatsBuckets = 1000
+
+	defaultTopNotesLimit = 10
+	defaultTopNotesRange = 7 * 24 * time.Hour
+
+	// maxMetricsClockSkew is how far in the future a view may be reported.
+	maxMetricsClockSkew = 5 * time.Minute
+)
+
+type viewKey struct {
+	noteID int64
+	bucket int64
+}
+
+type recordKey struct {
+	batchID  uuid.UUID
+	sequence int64
+}
+
+// IngestMetrics reads records with next until it returns io.EOF and adds
+// the accepted ones to the hourly counters of their notes. Records are
+// flushed in batches, every batch in its own transaction, so the records
+// flushed before an upload failed stay counted and are reported as
+// duplicated when the upload is retried. Records of notes not visible to
+// the user, with a negative counter or from the future are rejected,
+// views without ViewedAt are counted at the current hour.
+func (u *Usecase) IngestMetrics(
+	ctx context.Context,
+	userID int64,
+	next func() (entity.MetricRecord, error),
+) (entity.MetricsSummary, error) {
+	var summary entity.MetricsSummary
+
+	visible := make(map[int64]bool)
+	buffer := make([]entity.MetricRecord, 0, u.metricsBatchSize)
+	for {
+		rec, err := next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return entity.MetricsSummary{}, err
+		}
+
+		ok, err := u.acceptMetricRecord(ctx, userID, &rec, visible)
+		if err != nil {
+			return entity.MetricsSummary{}, fmt.Errorf("usecase ingest metrics: %w", err)
+		}
+		if !ok {
+			summary.Rejected++
+			continue
+		}
+
+		buffer = append(buffer, rec)
+		if len(buffer) < u.metricsBatchSize {
+			continue
+		}
+
+		if err := u.flushMetrics(ctx, userID, buffer, &summary); err != nil {
+			return entity.MetricsSummary{}, fmt.Errorf("usecase ingest metrics: %w", err)
+		}
+		buffer = buffer[:0]
+	}
+
+	if err := u.flushMetrics(ctx, userID, buffer, &summary); err != nil {
+		return entity.MetricsSummary{}, fmt.Errorf("usecase ingest metrics: %w", err)
+	}
+
+	return summary, nil
+}
+
+// acceptMetricRecord validates the record and moves ViewedAt to the start
+// of its hour. visible caches whether notes are visible to the user.
+func (u *Usecase) acceptMetricRecord(
+	ctx context.Context,
+	userID int64,
+	rec *entity.MetricRecord,
+	visible map[int64]bool,
+) (bool, error) {
+	now := time.Now()
+	if rec.NoteID <= 0 || rec.Count < 0 || rec.ViewedAt.After(now.Add(maxMetricsClockSkew)) {
+		return false, nil
+	}
+
+	ok, checked := visible[rec.NoteID]
+	if !checked {
+		err := u.authorize(ctx, userID, rec.NoteID, entity.NoteRoleViewer)
+		if err != nil && !errors.Is(err, entity.ErrNoteNotFound) && !errors.Is(err, entity.ErrNoteAccessDenied) {
+			return false, err
+		}
+		ok = err == nil
+		visible[rec.NoteID] = ok
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if rec.ViewedAt.IsZero() {
+		rec.ViewedAt = now
+	}
+	rec.ViewedAt = entity.StatsGranularityHour.Truncate(rec.ViewedAt)
+
+	return true, nil
+}
+
+// flushMetrics stores the records not received before and adds their views
+// to the counters in one transaction, then updates the summary.
+func (u *Usecase) flushMetrics(
+	ctx context.Context,
+	userID int64,
+	records []entity.MetricRecord,
+	summary *entity.MetricsSummary,
+) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	sequences := make(map[uuid.UUID][]int64)
+	for _, rec := range records {
+		sequences[rec.BatchID] = append(sequences[rec.BatchID], rec.Sequence)
+	}
+
+	// every upload locks batches in the same order to avoid deadlocks
+	batchIDs := slices.SortedFunc(maps.Keys(sequences), func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	var accepted []entity.MetricRecord
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		received := make(map[recordKey]struct{}, len(records))
+		for _, batchID := range batchIDs {
+			if err := u.repo.LockMetricsBatch(ctx, userID, batchID); err != nil {
+				return err
+			}
+
+			seqs, err := u.repo.ListMetricRecordSequences(ctx, userID, batchID, sequences[batchID])
+			if err != nil {
+				return err
+			}
+			for _, seq := range seqs {
+				received[recordKey{batchID: batchID, sequence: seq}] = struct{}{}
+			}
+		}
+
+		accepted = make([]entity.MetricRecord, 0, len(records))
+		for _, rec := range records {
+			key := recordKey{batchID: rec.BatchID, sequence: rec.Sequence}
+			// duplicates within the flushed records are skipped as well
+			if _, ok := received[key]; ok {
+				continue
+			}
+			received[key] = struct{}{}
+			accepted = append(accepted, rec)
+		}
+		if len(accepted) == 0 {
+			return nil
+		}
+
+		if err := u.repo.CopyMetricRecords(ctx, userID, accepted); err != nil {
+			return err
+		}
+
+		return u.repo.AddNoteViews(ctx, aggregateNoteViews(accepted))
+	})
+	if err != nil {
+		return err
+	}
+
+	summary.Accepted += int64(len(accepted))
+	summary.Duplicated += int64(len(records) - len(accepted))
+	for _, rec := range accepted {
+		summary.Views += rec.Count
+	}
+
+	return nil
+}
+
+// aggregateNoteViews sums views of the records by note and hour.
+func aggregateNoteViews(records []entity.MetricRecord) []entity.NoteView {
+	counters := make(map[viewKey]int64)
+	for _, rec := range records {
+		counters[viewKey{noteID: rec.NoteID, bucket: rec.ViewedAt.Unix()}] += rec.Count
+	}
+
+	views := make([]entity.NoteView, 0, len(counters))
+	for key, count := range counters {
+		views = append(views, entity.NoteView{
+			NoteID:   key.noteID,
+			ViewedAt: time.Unix(key.bucket, 0).UTC(),
+			Count:    count,
+		})
+	}
+
+	return views
+}
+
+// PurgeMetricRecords forgets records received before the time, it bounds
+// the window in which retried uploads are deduplicated.
+func (u *Usecase) PurgeMetricRecords(ctx context.Context, receivedBefore time.Time) (int64, error) {
+	purged, err := u.repo.PurgeMetricRecords(ctx, receivedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("usecase purge metric records: %w", err)
+	}
+
+	return purged, nil
+}
+
+// GetNoteStats returns views of the note per hour or day. Buckets cover
+// the range from the bucket of From to the bucket before To.
+func (u *Usecase) GetNoteStats(ctx context.Context, q entity.NoteStatsQuery) (entity.NoteStats, error) {
+	if q.Granularity == 0 {
+		q.Granularity = entity.StatsGranularityHour
+	}
+	if q.To.IsZero() {
+		q.To = time.Now()
+	}
+	if q.From.IsZero() {
+		q.From = q.To.Add(-defaultStatsRange(q.Granularity))
+	}
+
+	step := q.Granularity.Duration()
+	first := q.Granularity.Truncate(q.From)
+	last := q.Granularity.Truncate(q.To.Add(-time.Nanosecond))
+	if !q.From.Before(q.To) || last.Sub(first)/step >= maxStatsBuckets {
+		return entity.NoteStats{}, entity.ErrInvalidStatsRange
+	}
+
+	if err := u.authorize(ctx, q.UserID, q.NoteID, entity.NoteRoleViewer); err != nil {
+		return entity.NoteStats{}, fmt.Errorf("usecase get note stats: %w", err)
+	}
+
+	buckets, err := u.repo.GetNoteViewStats(ctx, q.NoteID, first, last, step)
+	if err != nil {
+		return entity.NoteStats{}, fmt.Errorf("usecase get note stats: %w", err)
+	}
+
+	stats := entity.NoteStats{Buckets: buckets}
+	for _, b := range buckets {
+		stats.TotalViews += b.Views
+	}
+
+	return stats, nil
+}
+
+// GetTopNotes returns the most viewed notes owned by or shared with
+// the user. Views are counted by hours, so the range is widened
+// to whole hours.
+func (u *Usecase) GetTopNotes(ctx context.Context, q entity.TopNotesQuery) ([]entity.NoteViews, error) {
+	if q.Limit == 0 {
+		q.Limit = defaultTopNotesLimit
+	}
+	if q.To.IsZero() {
+		q.To = time.Now()
+	}
+	if q.From.IsZero() {
+		q.From = q.To.Add(-defaultTopNotesRange)
+	}
+	if !q.From.Before(q.To) {
+		return nil, entity.ErrInvalidStatsRange
+	}
+
+	q.From = entity.StatsGranularityHour.Truncate(q.From)
+	q.To = entity.StatsGranularityHour.Truncate(q.To.Add(-time.Nanosecond)).Add(time.Hour)
+
+	top, err := u.repo.ListTopNotes(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("usecase get top notes: %w", err)
+	}
+
+	return top, nil
+}
+
+func defaultStatsRange(g entity.StatsGranularity) time.Duration {
+	if g == entity.StatsGranularityDay {
+		return 30 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour
+}
@@ -0,0 +1,246 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const expiredPresenceBatchSize = 500
+
+// EnterNotes marks the user present on the notes and returns the session,
+// it lasts for the presence TTL unless refreshed. The presence joined event
+// is recorded for the notes the user has not been present on yet.
+func (u *Usecase) EnterNotes(
+	ctx context.Context,
+	userID int64,
+	noteIDs []int64,
+	activity entity.PresenceActivity,
+) (uuid.UUID, error) {
+	// Notes are locked in id order, so concurrent sessions cannot deadlock.
+	noteIDs = slices.Compact(slices.Sorted(slices.Values(noteIDs)))
+	sessionID := uuid.New()
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		for _, noteID := range noteIDs {
+			if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+				return err
+			}
+
+			if err := u.enterNote(ctx, entity.PresenceSession{
+				NoteID:    noteID,
+				SessionID: sessionID,
+				UserID:    userID,
+				Activity:  activity,
+				ExpiresAt: time.Now().Add(u.presenceTTL),
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("usecase enter notes: %w", err)
+	}
+
+	return sessionID, nil
+}
+
+func (u *Usecase) enterNote(ctx context.Context, p entity.PresenceSession) error {
+	if err := u.repo.LockNotePresence(ctx, p.NoteID); err != nil {
+		return err
+	}
+
+	present, err := u.repo.CountUserPresence(ctx, p.NoteID, p.UserID)
+	if err != nil {
+		return err
+	}
+
+	p, err = u.repo.CreatePresence(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	if present > 0 {
+		return nil
+	}
+
+	return u.recordPresenceEvent(ctx, entity.NoteEventPresenceJoined, p)
+}
+
+// RefreshPresence extends the session for the presence TTL. It returns
+// ErrPresenceExpired when the session has expired before the refresh,
+// the user has to enter the notes again.
+func (u *Usecase) RefreshPresence(ctx context.Context, sessionID uuid.UUID) error {
+	refreshed, err := u.repo.RefreshPresence(ctx, sessionID, time.Now().Add(u.presenceTTL))
+	if err != nil {
+		return fmt.Errorf("usecase refresh presence: %w", err)
+	}
+	if refreshed == 0 {
+		return fmt.Errorf("usecase refresh presence: %w", entity.ErrPresenceExpired)
+	}
+
+	return nil
+}
+
+// LeaveNotes ends the session, the presence left event is recorded for
+// the notes the user is no longer present on.
+func (u *Usecase) LeaveNotes(ctx context.Context, sessionID uuid.UUID) error {
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		sessions, err := u.repo.ListSessionPresence(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range sessions {
+			if err := u.repo.LockNotePresence(ctx, p.NoteID); err != nil {
+				return err
+			}
+
+			if err := u.repo.DeletePresence(ctx, p.NoteID, p.SessionID); err != nil {
+				return err
+			}
+
+			if err := u.leaveNote(ctx, p); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("usecase leave notes: %w", err)
+	}
+
+	return nil
+}
+
+// leaveNote records the presence left event when the deleted session
+// was the last one of the user on the note.
+func (u *Usecase) leaveNote(ctx context.Context, p entity.PresenceSession) error {
+	present, err := u.repo.CountUserPresence(ctx, p.NoteID, p.UserID)
+	if err != nil {
+		return err
+	}
+	if present > 0 {
+		return nil
+	}
+
+	err = u.recordPresenceEvent(ctx, entity.NoteEventPresenceLeft, p)
+	if errors.Is(err, entity.ErrNoteNotFound) {
+		// Nobody is told about leaving a deleted note.
+		return nil
+	}
+
+	return err
+}
+
+func (u *Usecase) recordPresenceEvent(ctx context.Context, t entity.NoteEventType, p entity.PresenceSession) error {
+	note, err := u.repo.GetNote(ctx, p.NoteID)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.recordEvent(ctx, entity.NoteEvent{Type: t, Note: note, Presence: p})
+	return err
+}
+
+// GetPresence returns users present on the note, the earliest joined first.
+func (u *Usecase) GetPresence(ctx context.Context, userID, noteID int64) ([]entity.Presence, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return nil, fmt.Errorf("usecase get presence: %w", err)
+	}
+
+	sessions, err := u.repo.ListPresence(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase get presence: %w", err)
+	}
+
+	var presence []entity.Presence
+	byUser := make(map[int64]int)
+	for _, p := range sessions {
+		i, ok := byUser[p.UserID]
+		if !ok {
+			byUser[p.UserID] = len(presence)
+			presence = append(presence, entity.Presence{UserID: p.UserID, JoinedAt: p.JoinedAt})
+			i = len(presence) - 1
+		}
+
+		if !slices.Contains(presence[i].Activities, p.Activity) {
+			presence[i].Activities = append(presence[i].Activities, p.Activity)
+		}
+	}
+
+	for _, p := range presence {
+		slices.Sort(p.Activities)
+	}
+
+	return presence, nil
+}
+
+// RunPresenceExpiry ends sessions that have not been refreshed in time
+// until ctx is done, streams of a crashed server never leave their notes.
+// Expired sessions are looked for twice per presence TTL.
+func (u *Usecase) RunPresenceExpiry(ctx context.Context) error {
+	ticker := time.NewTicker(u.presenceTTL / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := u.expirePresence(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			slogx.Error(ctx, "expire presence", slogx.Err(err))
+		}
+	}
+}
+
+func (u *Usecase) expirePresence(ctx context.Context) error {
+	for {
+		expired, err := u.repo.ListExpiredPresence(ctx, expiredPresenceBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, p := range expired {
+			if err := u.expireSession(ctx, p); err != nil {
+				return err
+			}
+		}
+
+		if len(expired) < expiredPresenceBatchSize {
+			return nil
+		}
+	}
+}
+
+// expireSession deletes the session unless it has been refreshed or
+// deleted by another server instance meanwhile.
+func (u *Usecase) expireSession(ctx context.Context, p entity.PresenceSession) error {
+	return u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.LockNotePresence(ctx, p.NoteID); err != nil {
+			return err
+		}
+
+		deleted, err := u.repo.DeleteExpiredPresence(ctx, p.NoteID, p.SessionID)
+		if err != nil || !deleted {
+			return err
+		}
+
+		return u.leaveNote(ctx, p)
+	})
+}
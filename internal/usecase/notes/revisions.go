@@ -0,0 +1,103 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/diff"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func (u *Usecase) ListNoteRevisions(ctx context.Context, q entity.NoteRevisionsQuery) (entity.NoteRevisionsPage, error) {
+	if err := u.authorize(ctx, q.UserID, q.NoteID, entity.NoteRoleViewer); err != nil {
+		return entity.NoteRevisionsPage{}, fmt.Errorf("usecase list note revisions: %w", err)
+	}
+
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	q.PageSize = pageSize + 1
+
+	revs, err := u.repo.ListNoteRevisions(ctx, q)
+	if err != nil {
+		return entity.NoteRevisionsPage{}, fmt.Errorf("usecase list note revisions: %w", err)
+	}
+
+	if len(revs) <= pageSize {
+		return entity.NoteRevisionsPage{Revisions: revs}, nil
+	}
+
+	revs = revs[:pageSize]
+
+	return entity.NoteRevisionsPage{
+		Revisions: revs,
+		Next:      revs[pageSize-1].Revision,
+	}, nil
+}
+
+func (u *Usecase) GetNoteRevision(ctx context.Context, userID, noteID, revision int64) (entity.NoteRevision, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return entity.NoteRevision{}, fmt.Errorf("usecase get note revision: %w", err)
+	}
+
+	rev, err := u.repo.GetNoteRevision(ctx, noteID, revision)
+	if err != nil {
+		return entity.NoteRevision{}, fmt.Errorf("usecase get note revision: %w", err)
+	}
+
+	return rev, nil
+}
+
+// DiffNoteRevisions returns a unified diff of the note content between two revisions.
+func (u *Usecase) DiffNoteRevisions(ctx context.Context, userID, noteID, fromRevision, toRevision int64) (string, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleViewer); err != nil {
+		return "", fmt.Errorf("usecase diff note revisions: %w", err)
+	}
+
+	from, err := u.repo.GetNoteRevision(ctx, noteID, fromRevision)
+	if err != nil {
+		return "", fmt.Errorf("usecase diff note revisions: from: %w", err)
+	}
+
+	to, err := u.repo.GetNoteRevision(ctx, noteID, toRevision)
+	if err != nil {
+		return "", fmt.Errorf("usecase diff note revisions: to: %w", err)
+	}
+
+	return diff.Unified(
+		revisionLabel(from),
+		revisionLabel(to),
+		from.Content,
+		to.Content,
+	), nil
+}
+
+func revisionLabel(rev entity.NoteRevision) string {
+	return fmt.Sprintf("note/%d@%d", rev.NoteID, rev.Revision)
+}
+
+// RevertNote restores title and content of the note from toRevision.
+// The revert itself is stored as a new revision, so it can be reverted too.
+func (u *Usecase) RevertNote(ctx context.Context, id, toRevision int64, upd entity.NoteUpdate) (entity.Note, error) {
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		rev, err := u.repo.GetNoteRevision(ctx, id, toRevision)
+		if err != nil {
+			return err
+		}
+
+		upd.Title = &rev.Title
+		upd.Content = &rev.Content
+
+		event, err = u.updateNote(ctx, id, upd)
+		return err
+	})
+	if err != nil {
+		return entity.Note{}, fmt.Errorf("usecase revert note: %w", err)
+	}
+
+	slogx.Info(ctx, "success to revert note", slogx.UserId(upd.EditorID))
+	return event.Note, nil
+}
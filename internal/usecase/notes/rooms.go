@@ -0,0 +1,158 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const (
+	relayPageSize  = 500
+	reconnectDelay = time.Second
+)
+
+// rooms tracks participants connected to this server instance by note
+// and fans items out to them.
+type rooms[T any] struct {
+	// slowErr ends the participation of participants with a full queue.
+	slowErr error
+
+	mu    sync.Mutex
+	rooms map[int64]map[*participant[T]]struct{}
+}
+
+func newRooms[T any](slowErr error) *rooms[T] {
+	return &rooms[T]{
+		slowErr: slowErr,
+		rooms:   make(map[int64]map[*participant[T]]struct{}),
+	}
+}
+
+func (r *rooms[T]) join(noteID int64, queueSize int) *participant[T] {
+	p := &participant[T]{
+		noteID: noteID,
+		items:  make(chan T, queueSize),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	room, ok := r.rooms[noteID]
+	if !ok {
+		room = make(map[*participant[T]]struct{})
+		r.rooms[noteID] = room
+	}
+	room[p] = struct{}{}
+
+	return p
+}
+
+// publish queues the item for every participant of the room, participants
+// with a full queue are removed, so they never delay others.
+func (r *rooms[T]) publish(ctx context.Context, noteID int64, item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for p := range r.rooms[noteID] {
+		select {
+		case p.items <- item:
+		default:
+			r.remove(p, r.slowErr)
+			slogx.Warn(ctx, "remove slow room participant", slogx.Err(r.slowErr), slog.Int64("note_id", noteID))
+		}
+	}
+}
+
+func (r *rooms[T]) leave(p *participant[T], err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.remove(p, err)
+}
+
+// remove must be called with the lock held.
+func (r *rooms[T]) remove(p *participant[T], err error) {
+	room := r.rooms[p.noteID]
+	if _, ok := room[p]; !ok {
+		return
+	}
+
+	delete(room, p)
+	if len(room) == 0 {
+		delete(r.rooms, p.noteID)
+	}
+
+	p.err = err
+	close(p.items)
+}
+
+type participant[T any] struct {
+	noteID int64
+	items  chan T
+	err    error
+}
+
+// relay delivers items saved by every server instance to the rooms of this
+// one. It wakes up on notifications of the channel and reads items after
+// the last delivered id, so items saved while reconnecting are delivered
+// as well.
+type relay[T any] struct {
+	name    string
+	channel string
+	last    func(ctx context.Context) (int64, error)
+	list    func(ctx context.Context, after int64, limit int) ([]T, error)
+	id      func(T) int64
+	deliver func(context.Context, T)
+}
+
+// run delivers items saved after its start in id order until ctx is done.
+func (r relay[T]) run(ctx context.Context, l listener) error {
+	last, err := r.last(ctx)
+	if err != nil {
+		return fmt.Errorf("start %s: %v", r.name, err)
+	}
+
+	slogx.Info(ctx, "run "+r.name)
+
+	for {
+		err := l.Listen(ctx, r.channel, func(ctx context.Context) error {
+			var err error
+			last, err = r.relay(ctx, last)
+			return err
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		slogx.Warn(ctx, r.name+" disconnected", slogx.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// relay delivers items after the id and returns the last one.
+func (r relay[T]) relay(ctx context.Context, last int64) (int64, error) {
+	for {
+		items, err := r.list(ctx, last, relayPageSize)
+		if err != nil {
+			return last, err
+		}
+
+		for _, item := range items {
+			r.deliver(ctx, item)
+			last = r.id(item)
+		}
+
+		if len(items) < relayPageSize {
+			return last, nil
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package notes
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const shareTokenSize = 32
+
+// CreateShareLink mints a read-only link to the note. Zero expiresAt means
+// the link never expires, empty password means no password is asked.
+func (u *Usecase) CreateShareLink(
+	ctx context.Context,
+	userID, noteID int64,
+	expiresAt time.Time,
+	password string,
+) (entity.ShareLink, error) {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleOwner); err != nil {
+		return entity.ShareLink{}, fmt.Errorf("usecase create share link: %w", err)
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return entity.ShareLink{}, fmt.Errorf("usecase create share link: %w", err)
+	}
+
+	link := entity.ShareLink{
+		NoteID:    noteID,
+		TokenHash: hashShareToken(token),
+		ExpiresAt: expiresAt,
+		CreatedBy: userID,
+	}
+
+	if password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return entity.ShareLink{}, fmt.Errorf("usecase create share link: hash password: %w", err)
+		}
+		link.PasswordHash = string(hash)
+	}
+
+	link, err = u.repo.CreateShareLink(ctx, link)
+	if err != nil {
+		return entity.ShareLink{}, fmt.Errorf("usecase create share link: %w", err)
+	}
+	link.Token = token
+
+	slogx.Info(ctx, "success to create share link", slogx.UserId(userID))
+	return link, nil
+}
+
+func (u *Usecase) RevokeShareLink(ctx context.Context, userID, noteID, linkID int64) error {
+	if err := u.authorize(ctx, userID, noteID, entity.NoteRoleOwner); err != nil {
+		return fmt.Errorf("usecase revoke share link: %w", err)
+	}
+
+	if err := u.repo.RevokeShareLink(ctx, noteID, linkID); err != nil {
+		return fmt.Errorf("usecase revoke share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetSharedNote opens a note by a share link token, no user is required.
+func (u *Usecase) GetSharedNote(ctx context.Context, token, password string) (entity.SharedNote, error) {
+	shared, err := u.repo.GetSharedNote(ctx, hashShareToken(token))
+	if err != nil {
+		return entity.SharedNote{}, fmt.Errorf("usecase get shared note: %w", err)
+	}
+
+	if shared.PasswordHash != "" {
+		err := bcrypt.CompareHashAndPassword([]byte(shared.PasswordHash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return entity.SharedNote{}, fmt.Errorf("usecase get shared note: %w", entity.ErrShareLinkPasswordInvalid)
+		}
+		if err != nil {
+			return entity.SharedNote{}, fmt.Errorf("usecase get shared note: compare password: %w", err)
+		}
+	}
+
+	return shared, nil
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, shareTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate share token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashShareToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,79 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+func (u *Usecase) ListTags(ctx context.Context, userID int64) ([]entity.Tag, error) {
+	tags, err := u.repo.ListTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (u *Usecase) RenameTag(ctx context.Context, userID int64, name, newName string) (entity.Tag, error) {
+	tag, err := u.repo.RenameTag(ctx, userID, strings.TrimSpace(name), strings.TrimSpace(newName))
+	if err != nil {
+		return entity.Tag{}, fmt.Errorf("usecase rename tag: %w", err)
+	}
+
+	slogx.Info(ctx, "success to rename tag", slogx.UserId(userID))
+	return tag, nil
+}
+
+// MergeTags retags notes of the source tags with the target tag, which is
+// created if missing, and removes the source tags.
+func (u *Usecase) MergeTags(ctx context.Context, userID int64, sources []string, target string) error {
+	target = strings.TrimSpace(target)
+	sources = slices.DeleteFunc(normalizeTags(sources), func(s string) bool {
+		return s == target
+	})
+	if len(sources) == 0 {
+		return nil
+	}
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		merged, err := u.repo.MergeTags(ctx, userID, sources, target)
+		if err != nil {
+			return err
+		}
+
+		if merged == 0 {
+			return entity.ErrTagNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("usecase merge tags: %w", err)
+	}
+
+	slogx.Info(ctx, "success to merge tags", slogx.UserId(userID))
+	return nil
+}
+
+// normalizeTags trims tag names and drops empty and duplicate ones.
+// The result is sorted, the same way the repository returns note tags.
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+
+	slices.Sort(result)
+	return slices.Compact(result)
+}
@@ -0,0 +1,96 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+)
+
+const purgeBatchSize = 500
+
+func (u *Usecase) ListTrash(ctx context.Context, q entity.NotesQuery) (entity.NotesPage, error) {
+	q.OrderBy = entity.NoteOrderByDeletedAt
+	q.Descending = true
+
+	page, err := listPage(ctx, q, u.repo.ListTrashedNotes)
+	if err != nil {
+		return entity.NotesPage{}, fmt.Errorf("usecase list trash: %w", err)
+	}
+
+	return page, nil
+}
+
+func (u *Usecase) RestoreNote(ctx context.Context, userID, id int64) (entity.Note, error) {
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorizeTrashed(ctx, userID, id, entity.NoteRoleOwner); err != nil {
+			return err
+		}
+
+		note, err := u.repo.RestoreNote(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		event, err = u.recordEvent(ctx, entity.NoteEvent{Type: entity.NoteEventRestored, Note: note})
+		return err
+	})
+	if err != nil {
+		return entity.Note{}, fmt.Errorf("usecase restore note: %w", err)
+	}
+
+	slogx.Info(ctx, "success to restore note", slogx.UserId(event.Note.UserID))
+	return event.Note, nil
+}
+
+func (u *Usecase) PurgeNote(ctx context.Context, userID, id int64) error {
+	if err := u.authorizeTrashed(ctx, userID, id, entity.NoteRoleOwner); err != nil {
+		return fmt.Errorf("usecase purge note: %w", err)
+	}
+
+	return u.purgeNote(ctx, id)
+}
+
+// AdminPurgeNote is PurgeNote for a trashed note of any user.
+func (u *Usecase) AdminPurgeNote(ctx context.Context, id int64) error {
+	return u.purgeNote(ctx, id)
+}
+
+func (u *Usecase) purgeNote(ctx context.Context, id int64) error {
+	if err := u.repo.PurgeNote(ctx, id); err != nil {
+		return fmt.Errorf("usecase purge note: %w", err)
+	}
+
+	// The note is gone already, leftover blobs are retried by the next purge.
+	if err := u.removeOrphanedAttachments(ctx); err != nil {
+		slogx.Error(ctx, "remove orphaned attachments", slogx.Err(err))
+	}
+
+	return nil
+}
+
+// PurgeTrash permanently removes notes trashed before deletedBefore.
+// Notes are removed in batches to keep transactions short.
+func (u *Usecase) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var total int64
+	for {
+		purged, err := u.repo.PurgeDeletedNotes(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("usecase purge trash: %w", err)
+		}
+
+		total += purged
+		if purged < purgeBatchSize {
+			break
+		}
+	}
+
+	if err := u.removeOrphanedAttachments(ctx); err != nil {
+		return total, fmt.Errorf("usecase purge trash: %w", err)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,108 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+// trashRepo keeps trashed notes of note 1 owned by user 1 and shared
+// with user 2 as an editor.
+type trashRepo struct {
+	notesRepository
+
+	trashed    []int64
+	roleChecks int
+}
+
+func (r *trashRepo) GetNoteRole(_ context.Context, noteID, userID int64, includeTrashed bool) (entity.NoteRole, error) {
+	r.roleChecks++
+
+	if noteID != 1 || !includeTrashed {
+		return entity.NoteRoleNone, entity.ErrNoteNotFound
+	}
+
+	switch userID {
+	case 1:
+		return entity.NoteRoleOwner, nil
+	case 2:
+		return entity.NoteRoleEditor, nil
+	default:
+		return entity.NoteRoleNone, nil
+	}
+}
+
+func (r *trashRepo) PurgeNote(_ context.Context, id int64) error {
+	i := slices.Index(r.trashed, id)
+	if i < 0 {
+		return entity.ErrNoteNotFound
+	}
+	r.trashed = slices.Delete(r.trashed, i, i+1)
+
+	return nil
+}
+
+func (r *trashRepo) ListOrphanedAttachments(context.Context, int) ([]entity.Attachment, error) {
+	return nil, nil
+}
+
+func TestPurgeNote(t *testing.T) {
+	tests := []struct {
+		name           string
+		purge          func(u *Usecase) error
+		wantErr        error
+		wantPurged     bool
+		wantRoleChecks int
+	}{
+		{
+			name:           "owner",
+			purge:          func(u *Usecase) error { return u.PurgeNote(context.Background(), 1, 1) },
+			wantPurged:     true,
+			wantRoleChecks: 1,
+		},
+		{
+			name:           "editor",
+			purge:          func(u *Usecase) error { return u.PurgeNote(context.Background(), 2, 1) },
+			wantErr:        entity.ErrNoteAccessDenied,
+			wantRoleChecks: 1,
+		},
+		{
+			name:           "other user",
+			purge:          func(u *Usecase) error { return u.PurgeNote(context.Background(), 3, 1) },
+			wantErr:        entity.ErrNoteAccessDenied,
+			wantRoleChecks: 1,
+		},
+		{
+			name:       "admin",
+			purge:      func(u *Usecase) error { return u.AdminPurgeNote(context.Background(), 1) },
+			wantPurged: true,
+		},
+		{
+			name:    "admin, note not in trash",
+			purge:   func(u *Usecase) error { return u.AdminPurgeNote(context.Background(), 5) },
+			wantErr: entity.ErrNoteNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &trashRepo{trashed: []int64{1}}
+			u := &Usecase{Options: Options{repo: repo}}
+
+			err := tt.purge(u)
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("purge error = %v, want %v", err, tt.wantErr)
+			}
+
+			if purged := !slices.Contains(repo.trashed, 1); purged != tt.wantPurged {
+				t.Errorf("note purged = %v, want %v", purged, tt.wantPurged)
+			}
+			if repo.roleChecks != tt.wantRoleChecks {
+				t.Errorf("role checks = %d, want %d", repo.roleChecks, tt.wantRoleChecks)
+			}
+		})
+	}
+}
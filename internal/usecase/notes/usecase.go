@@ -3,28 +3,147 @@ package notes
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/imkira/go-observer"
+	"github.com/google/uuid"
 
+	"github.com/evgeniy-krivenko/grpc-notes/internal/broker"
 	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
 	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
 )
 
+const defaultPageSize = 50
+
 type notesRepository interface {
 	CreateNote(ctx context.Context, userID int64, title, content string) (entity.Note, error)
 	GetNote(ctx context.Context, id int64) (entity.Note, error)
-	GetNotesByUserID(ctx context.Context, userID int64) ([]entity.Note, error)
-	DeleteNote(ctx context.Context, id int64) error
+	ListNotes(ctx context.Context, q entity.NotesQuery) ([]entity.Note, error)
+	SearchNotes(ctx context.Context, q entity.SearchQuery) ([]entity.SearchResult, error)
+	UpdateNote(ctx context.Context, id int64, upd entity.NoteUpdate) (entity.Note, error)
+	DeleteNote(ctx context.Context, id, revision int64) (entity.Note, error)
+	ListTrashedNotes(ctx context.Context, q entity.NotesQuery) ([]entity.Note, error)
+	RestoreNote(ctx context.Context, id int64) (entity.Note, error)
+	PurgeNote(ctx context.Context, id int64) error
+	PurgeDeletedNotes(ctx context.Context, deletedBefore time.Time, batchSize int) (int64, error)
+
+	CreateNoteRevision(ctx context.Context, rev entity.NoteRevision) (entity.NoteRevision, error)
+	GetNoteRevision(ctx context.Context, noteID, revision int64) (entity.NoteRevision, error)
+	ListNoteRevisions(ctx context.Context, q entity.NoteRevisionsQuery) ([]entity.NoteRevision, error)
+
+	SetNoteTags(ctx context.Context, userID, noteID int64, tags []string) error
+	ListTags(ctx context.Context, userID int64) ([]entity.Tag, error)
+	RenameTag(ctx context.Context, userID int64, name, newName string) (entity.Tag, error)
+	MergeTags(ctx context.Context, userID int64, sources []string, target string) (int64, error)
+
+	GetNotebook(ctx context.Context, userID, id int64) (entity.Notebook, error)
+	MoveNote(ctx context.Context, id, notebookID, revision int64) (entity.Note, error)
+
+	GetNoteRole(ctx context.Context, noteID, userID int64, includeTrashed bool) (entity.NoteRole, error)
+	UpsertCollaborator(ctx context.Context, c entity.Collaborator) (entity.Collaborator, error)
+	DeleteCollaborator(ctx context.Context, noteID, userID int64) error
+	ListCollaborators(ctx context.Context, noteID int64) ([]entity.Collaborator, error)
+
+	AppendNoteEvent(ctx context.Context, event entity.NoteEvent) error
+	ListNoteEvents(ctx context.Context, q entity.NoteEventsQuery) ([]entity.NoteEvent, error)
+	PurgeNoteEvents(ctx context.Context, createdBefore time.Time, batchSize int) (int64, error)
+
+	CreateShareLink(ctx context.Context, link entity.ShareLink) (entity.ShareLink, error)
+	RevokeShareLink(ctx context.Context, noteID, linkID int64) error
+	GetSharedNote(ctx context.Context, tokenHash []byte) (entity.SharedNote, error)
+
+	CreateAttachment(ctx context.Context, a entity.Attachment) (entity.Attachment, error)
+	GetAttachment(ctx context.Context, noteID, id int64) (entity.Attachment, error)
+	ListAttachments(ctx context.Context, noteID int64) ([]entity.Attachment, error)
+	ListOrphanedAttachments(ctx context.Context, limit int) ([]entity.Attachment, error)
+	DeleteAttachments(ctx context.Context, ids []int64) error
+
+	AddNoteViews(ctx context.Context, views []entity.NoteView) error
+	LockMetricsBatch(ctx context.Context, userID int64, batchID uuid.UUID) error
+	ListMetricRecordSequences(ctx context.Context, userID int64, batchID uuid.UUID, sequences []int64) ([]int64, error)
+	CopyMetricRecords(ctx context.Context, userID int64, records []entity.MetricRecord) error
+	PurgeMetricRecords(ctx context.Context, receivedBefore time.Time) (int64, error)
+	GetNoteViewStats(ctx context.Context, noteID int64, first, last time.Time, step time.Duration) ([]entity.ViewBucket, error)
+	ListTopNotes(ctx context.Context, q entity.TopNotesQuery) ([]entity.NoteViews, error)
+
+	CreateChatMessage(ctx context.Context, m entity.ChatMessage) (entity.ChatMessage, error)
+	ListChatMessages(ctx context.Context, q entity.ChatMessagesQuery) ([]entity.ChatMessage, error)
+	GetLastChatMessageID(ctx context.Context) (int64, error)
+	ListChatMessagesAfter(ctx context.Context, after int64, limit int) ([]entity.ChatMessage, error)
+
+	LockNoteDocument(ctx context.Context, noteID int64) (entity.NoteDocument, error)
+	UpdateNoteDocument(ctx context.Context, d entity.NoteDocument) error
+	ListDirtyNoteDocuments(ctx context.Context, limit int) ([]int64, error)
+	AppendNoteEdit(ctx context.Context, e entity.NoteEdit) (entity.NoteEdit, error)
+	ListNoteEditOperations(ctx context.Context, noteID, afterVersion int64) ([]entity.NoteEdit, error)
+	ListNoteEditCursors(ctx context.Context, noteID int64, after time.Time) ([]entity.NoteEdit, error)
+	GetLastNoteEditID(ctx context.Context) (int64, error)
+	ListNoteEditsAfter(ctx context.Context, after int64, limit int) ([]entity.NoteEdit, error)
+	PurgeNoteEdits(ctx context.Context, createdBefore time.Time) (int64, error)
+
+	LockNotePresence(ctx context.Context, noteID int64) error
+	CreatePresence(ctx context.Context, p entity.PresenceSession) (entity.PresenceSession, error)
+	CountUserPresence(ctx context.Context, noteID, userID int64) (int64, error)
+	RefreshPresence(ctx context.Context, sessionID uuid.UUID, expiresAt time.Time) (int64, error)
+	ListSessionPresence(ctx context.Context, sessionID uuid.UUID) ([]entity.PresenceSession, error)
+	ListExpiredPresence(ctx context.Context, limit int) ([]entity.PresenceSession, error)
+	DeletePresence(ctx context.Context, noteID int64, sessionID uuid.UUID) error
+	DeleteExpiredPresence(ctx context.Context, noteID int64, sessionID uuid.UUID) (bool, error)
+	ListPresence(ctx context.Context, noteID int64) ([]entity.PresenceSession, error)
+}
+
+// eventBus delivers events recorded by any server instance in sequence order.
+type eventBus interface {
+	Run(ctx context.Context, deliver func(entity.NoteEvent)) error
+}
+
+type eventBroker interface {
+	Publish(ctx context.Context, event entity.NoteEvent)
+	Subscribe(accept func(entity.NoteEvent) bool) *broker.Subscription
+}
+
+type listener interface {
+	Listen(ctx context.Context, channel string, handle func(context.Context) error) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
 }
 
 //go:generate go run github.com/kazhuravlev/options-gen/cmd/options-gen@v0.55.2 -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
-	repo notesRepository `option:"mandatory" validate:"required"`
+	repo   notesRepository `option:"mandatory" validate:"required"`
+	tx     transactor      `option:"mandatory" validate:"required"`
+	blobs  blobStorage     `option:"mandatory" validate:"required"`
+	bus    eventBus        `option:"mandatory" validate:"required"`
+	events eventBroker     `option:"mandatory" validate:"required"`
+	// listener wakes up the chat relay on chatChannel
+	// and the edit relay on editChannel.
+	listener    listener `option:"mandatory" validate:"required"`
+	chatChannel string   `option:"mandatory" validate:"required"`
+	editChannel string   `option:"mandatory" validate:"required"`
+
+	maxAttachmentSize int64 `default:"26214400" validate:"min=1"`
+	// metricsBatchSize is the number of uploaded metric records
+	// flushed to the database at once.
+	metricsBatchSize int `default:"500" validate:"min=1,max=10000"`
+	// chatQueueSize is the number of messages queued for a chat participant,
+	// a participant with a full queue is removed from the room.
+	chatQueueSize int `default:"64" validate:"min=1"`
+	// editQueueSize is the number of edits queued for an edit session
+	// participant, a participant with a full queue leaves the session.
+	editQueueSize int `default:"256" validate:"min=1"`
+	// snapshotInterval is how often edit session documents
+	// are saved to the notes.
+	snapshotInterval time.Duration `default:"5s" validate:"min=100ms"`
+	// presenceTTL is how long a presence session lasts without a refresh.
+	presenceTTL time.Duration `default:"15s" validate:"min=1s"`
 }
 
 type Usecase struct {
 	Options
-	observer observer.Property
+
+	chatRooms *rooms[entity.ChatMessage]
+	editRooms *rooms[entity.NoteEdit]
 }
 
 func New(opts Options) (*Usecase, error) {
@@ -32,24 +151,50 @@ func New(opts Options) (*Usecase, error) {
 		return nil, fmt.Errorf("validate notes usecase options: %v", err)
 	}
 
-	prop := observer.NewProperty(entity.Note{})
-
-	return &Usecase{Options: opts, observer: prop}, nil
+	return &Usecase{
+		Options:   opts,
+		chatRooms: newRooms[entity.ChatMessage](entity.ErrSlowChatParticipant),
+		editRooms: newRooms[entity.NoteEdit](entity.ErrSlowEditParticipant),
+	}, nil
 }
 
-func (u *Usecase) CreateNote(ctx context.Context, userID int64, title, content string) (entity.Note, error) {
-	note, err := u.repo.CreateNote(ctx, userID, title, content)
+func (u *Usecase) CreateNote(ctx context.Context, userID int64, title, content string, tags []string) (entity.Note, error) {
+	tags = normalizeTags(tags)
+
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		note, err := u.repo.CreateNote(ctx, userID, title, content)
+		if err != nil {
+			return err
+		}
+
+		if len(tags) > 0 {
+			if err := u.repo.SetNoteTags(ctx, userID, note.ID, tags); err != nil {
+				return err
+			}
+			note.Tags = tags
+		}
+
+		if err := u.saveRevision(ctx, note, userID); err != nil {
+			return err
+		}
+
+		event, err = u.recordEvent(ctx, entity.NoteEvent{Type: entity.NoteEventCreated, Note: note})
+		return err
+	})
 	if err != nil {
 		return entity.Note{}, fmt.Errorf("usecase create note: %w", err)
 	}
 
-	u.observer.Update(note)
-
 	slogx.Info(ctx, "success to create note", slogx.UserId(userID))
-	return note, nil
+	return event.Note, nil
 }
 
-func (u *Usecase) GetNote(ctx context.Context, id int64) (entity.Note, error) {
+func (u *Usecase) GetNote(ctx context.Context, userID, id int64) (entity.Note, error) {
+	if err := u.authorize(ctx, userID, id, entity.NoteRoleViewer); err != nil {
+		return entity.Note{}, fmt.Errorf("usecase get note: %w", err)
+	}
+
 	note, err := u.repo.GetNote(ctx, id)
 	if err != nil {
 		return entity.Note{}, fmt.Errorf("usecase get note: %w", err)
@@ -58,47 +203,181 @@ func (u *Usecase) GetNote(ctx context.Context, id int64) (entity.Note, error) {
 	return note, nil
 }
 
-func (u *Usecase) GetNotesByUserID(ctx context.Context, userID int64) ([]entity.Note, error) {
-	notes, err := u.repo.GetNotesByUserID(ctx, userID)
+func (u *Usecase) ListNotes(ctx context.Context, q entity.NotesQuery) (entity.NotesPage, error) {
+	q.Tags = normalizeTags(q.Tags)
+
+	page, err := listPage(ctx, q, u.repo.ListNotes)
 	if err != nil {
-		return nil, fmt.Errorf("usecase get notes by user: %w", err)
+		return entity.NotesPage{}, fmt.Errorf("usecase list notes: %w", err)
 	}
 
-	return notes, nil
+	return page, nil
 }
 
-func (u *Usecase) DeleteNote(ctx context.Context, id int64) error {
-	if err := u.repo.DeleteNote(ctx, id); err != nil {
-		return fmt.Errorf("usecase delete note: %w", err)
+func listPage(
+	ctx context.Context,
+	q entity.NotesQuery,
+	list func(context.Context, entity.NotesQuery) ([]entity.Note, error),
+) (entity.NotesPage, error) {
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
 	}
 
-	return nil
+	// fetch one extra note to find out whether there is a next page
+	q.PageSize = pageSize + 1
+
+	notes, err := list(ctx, q)
+	if err != nil {
+		return entity.NotesPage{}, err
+	}
+
+	if len(notes) <= pageSize {
+		return entity.NotesPage{Notes: notes}, nil
+	}
+
+	notes = notes[:pageSize]
+
+	return entity.NotesPage{
+		Notes: notes,
+		Next:  entity.NewNoteCursor(notes[pageSize-1], q.OrderBy),
+	}, nil
+}
+
+func (u *Usecase) SearchNotes(ctx context.Context, q entity.SearchQuery) (entity.SearchPage, error) {
+	pageSize := q.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	q.PageSize = pageSize + 1
+
+	if q.Language == "" {
+		q.Language = entity.SearchLanguageSimple
+	}
+
+	results, err := u.repo.SearchNotes(ctx, q)
+	if err != nil {
+		return entity.SearchPage{}, fmt.Errorf("usecase search notes: %w", err)
+	}
+
+	if len(results) <= pageSize {
+		return entity.SearchPage{Results: results}, nil
+	}
+
+	results = results[:pageSize]
+	last := results[pageSize-1]
+
+	return entity.SearchPage{
+		Results: results,
+		Next:    &entity.SearchCursor{ID: last.Note.ID, Rank: last.Rank},
+	}, nil
 }
 
-func (u *Usecase) SubscribeToEvents(ctx context.Context, userID int64) (<-chan entity.CreateNoteEvent, error) {
-	// ignore user id for simplicity
+func (u *Usecase) UpdateNote(ctx context.Context, id int64, upd entity.NoteUpdate) (entity.Note, error) {
+	if upd.Tags != nil {
+		upd.Tags = normalizeTags(upd.Tags)
+	}
+
+	event, err := u.updateNote(ctx, id, upd)
+	if err != nil {
+		return entity.Note{}, fmt.Errorf("usecase update note: %w", err)
+	}
 
-	stream := u.observer.Observe()
+	slogx.Info(ctx, "success to update note", slogx.UserId(event.Note.UserID))
+	return event.Note, nil
+}
 
-	result := make(chan entity.CreateNoteEvent)
-	go func() {
-		defer close(result)
-		for {
-			select {
-			case <-ctx.Done():
-				return
+// updateNote applies the update, records the new revision and
+// the update event in one transaction.
+func (u *Usecase) updateNote(ctx context.Context, id int64, upd entity.NoteUpdate) (entity.NoteEvent, error) {
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, upd.EditorID, id, entity.NoteRoleEditor); err != nil {
+			return err
+		}
 
-			case <-stream.Changes():
-				note := stream.Next().(entity.Note)
+		note, err := u.repo.UpdateNote(ctx, id, upd)
+		if err != nil {
+			return err
+		}
 
-				select {
-				case <-ctx.Done():
-					return
-				case result <- entity.CreateNoteEvent{CreatedNote: note}:
-				}
+		if upd.Tags != nil {
+			if err := u.repo.SetNoteTags(ctx, note.UserID, id, upd.Tags); err != nil {
+				return err
 			}
+			note.Tags = upd.Tags
 		}
-	}()
 
-	return result, nil
+		if err := u.saveRevision(ctx, note, upd.EditorID); err != nil {
+			return err
+		}
+
+		event, err = u.recordEvent(ctx, entity.NoteEvent{Type: entity.NoteEventUpdated, Note: note})
+		return err
+	})
+
+	return event, err
+}
+
+func (u *Usecase) saveRevision(ctx context.Context, note entity.Note, authorID int64) error {
+	_, err := u.repo.CreateNoteRevision(ctx, entity.NoteRevision{
+		NoteID:   note.ID,
+		Revision: note.Revision,
+		AuthorID: authorID,
+		Title:    note.Title,
+		Content:  note.Content,
+	})
+
+	return err
+}
+
+// MoveNote puts the note into a notebook of the user, notebookID 0 takes
+// the note out of any notebook. Only note owners can move notes.
+func (u *Usecase) MoveNote(ctx context.Context, userID, id, notebookID, revision int64) (entity.Note, error) {
+	var event entity.NoteEvent
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, userID, id, entity.NoteRoleOwner); err != nil {
+			return err
+		}
+
+		if notebookID != 0 {
+			if _, err := u.repo.GetNotebook(ctx, userID, notebookID); err != nil {
+				return err
+			}
+		}
+
+		note, err := u.repo.MoveNote(ctx, id, notebookID, revision)
+		if err != nil {
+			return err
+		}
+
+		event, err = u.recordEvent(ctx, entity.NoteEvent{Type: entity.NoteEventUpdated, Note: note})
+		return err
+	})
+	if err != nil {
+		return entity.Note{}, fmt.Errorf("usecase move note: %w", err)
+	}
+
+	return event.Note, nil
+}
+
+func (u *Usecase) DeleteNote(ctx context.Context, userID, id, revision int64) error {
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		if err := u.authorize(ctx, userID, id, entity.NoteRoleOwner); err != nil {
+			return err
+		}
+
+		note, err := u.repo.DeleteNote(ctx, id, revision)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.recordEvent(ctx, entity.NoteEvent{Type: entity.NoteEventDeleted, Note: note})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("usecase delete note: %w", err)
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@ package notes
 
 import (
 	fmt461e464ebed9 "fmt"
+	"time"
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
@@ -13,13 +14,34 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	repo notesRepository,
+	tx transactor,
+	blobs blobStorage,
+	bus eventBus,
+	events eventBroker,
+	listener listener,
+	chatChannel string,
+	editChannel string,
 	options ...OptOptionsSetter,
 ) Options {
 	var o Options
 
 	// Setting defaults from field tag (if present)
 
+	o.maxAttachmentSize = 26214400
+	o.metricsBatchSize = 500
+	o.chatQueueSize = 64
+	o.editQueueSize = 256
+	o.snapshotInterval, _ = time.ParseDuration("5s")
+	o.presenceTTL, _ = time.ParseDuration("15s")
+
 	o.repo = repo
+	o.tx = tx
+	o.blobs = blobs
+	o.bus = bus
+	o.events = events
+	o.listener = listener
+	o.chatChannel = chatChannel
+	o.editChannel = editChannel
 
 	for _, opt := range options {
 		opt(&o)
@@ -27,9 +49,55 @@ func NewOptions(
 	return o
 }
 
+func WithMaxAttachmentSize(opt int64) OptOptionsSetter {
+	return func(o *Options) { o.maxAttachmentSize = opt }
+}
+
+// metricsBatchSize is the number of uploaded metric records
+// flushed to the database at once.
+func WithMetricsBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) { o.metricsBatchSize = opt }
+}
+
+// chatQueueSize is the number of messages queued for a chat participant,
+// a participant with a full queue is removed from the room.
+func WithChatQueueSize(opt int) OptOptionsSetter {
+	return func(o *Options) { o.chatQueueSize = opt }
+}
+
+// editQueueSize is the number of edits queued for an edit session
+// participant, a participant with a full queue leaves the session.
+func WithEditQueueSize(opt int) OptOptionsSetter {
+	return func(o *Options) { o.editQueueSize = opt }
+}
+
+// snapshotInterval is how often edit session documents
+// are saved to the notes.
+func WithSnapshotInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.snapshotInterval = opt }
+}
+
+// presenceTTL is how long a presence session lasts without a refresh.
+func WithPresenceTTL(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.presenceTTL = opt }
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("repo", _validate_Options_repo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("tx", _validate_Options_tx(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("blobs", _validate_Options_blobs(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("bus", _validate_Options_bus(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("events", _validate_Options_events(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("listener", _validate_Options_listener(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("chatChannel", _validate_Options_chatChannel(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editChannel", _validate_Options_editChannel(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxAttachmentSize", _validate_Options_maxAttachmentSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("metricsBatchSize", _validate_Options_metricsBatchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("chatQueueSize", _validate_Options_chatQueueSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("editQueueSize", _validate_Options_editQueueSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("snapshotInterval", _validate_Options_snapshotInterval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("presenceTTL", _validate_Options_presenceTTL(o)))
 	return errs.AsError()
 }
 
@@ -39,3 +107,94 @@ func _validate_Options_repo(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_tx(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.tx, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `tx` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_blobs(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.blobs, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `blobs` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_bus(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.bus, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `bus` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_events(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.events, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `events` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_listener(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.listener, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `listener` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_chatChannel(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatChannel, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `chatChannel` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editChannel(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editChannel, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editChannel` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxAttachmentSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxAttachmentSize, "min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxAttachmentSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_metricsBatchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.metricsBatchSize, "min=1,max=10000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `metricsBatchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_chatQueueSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatQueueSize, "min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `chatQueueSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_editQueueSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.editQueueSize, "min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `editQueueSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_snapshotInterval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.snapshotInterval, "min=100ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `snapshotInterval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_presenceTTL(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.presenceTTL, "min=1s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `presenceTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/webhook"
+)
+
+// payload is the JSON body posted to webhooks.
+type payload struct {
+	Sequence     int64                `json:"sequence"`
+	Event        string               `json:"event"`
+	CreatedAt    time.Time            `json:"created_at"`
+	Note         notePayload          `json:"note"`
+	Collaborator *collaboratorPayload `json:"collaborator,omitempty"`
+	Presence     *presencePayload     `json:"presence,omitempty"`
+}
+
+type notePayload struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Title      string     `json:"title"`
+	Content    string     `json:"content"`
+	Tags       []string   `json:"tags"`
+	NotebookID int64      `json:"notebook_id,omitempty"`
+	Revision   int64      `json:"revision"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+type collaboratorPayload struct {
+	UserID    int64     `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type presencePayload struct {
+	UserID   int64  `json:"user_id"`
+	Activity string `json:"activity"`
+}
+
+// RunDispatcher turns note events into webhook deliveries and delivers them
+// until ctx is done. Both steps lock their rows in the database, so every
+// server instance may run a dispatcher.
+func (u *Usecase) RunDispatcher(ctx context.Context) error {
+	slogx.Info(ctx, "run webhook dispatcher",
+		slog.Int("workers", u.workers),
+		slog.Duration("interval", u.interval),
+	)
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	eg.Go(func() error {
+		u.poll(ctx, "enqueue webhook deliveries", u.enqueue)
+		return nil
+	})
+	for range u.workers {
+		eg.Go(func() error {
+			u.poll(ctx, "deliver webhook", u.deliver)
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// poll calls step while it has done some work and waits for the interval
+// when it has not.
+func (u *Usecase) poll(ctx context.Context, name string, step func(context.Context) (bool, error)) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		busy, err := step(ctx)
+		if err != nil && ctx.Err() == nil {
+			slogx.Error(ctx, name, slogx.Err(err))
+		}
+
+		if busy && err == nil {
+			timer.Reset(0)
+		} else {
+			timer.Reset(u.interval)
+		}
+	}
+}
+
+// enqueue adds deliveries for a batch of note events logged after
+// the cursor and moves the cursor past them.
+func (u *Usecase) enqueue(ctx context.Context) (bool, error) {
+	var events []entity.NoteEvent
+
+	err := u.tx.RunInTx(ctx, func(ctx context.Context) error {
+		after, err := u.repo.LockWebhookCursor(ctx)
+		if err != nil {
+			return err
+		}
+
+		events, err = u.repo.ListNoteEventsAfter(ctx, after, u.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		for _, event := range events {
+			body, err := json.Marshal(eventToPayload(event))
+			if err != nil {
+				return fmt.Errorf("marshal webhook payload: %v", err)
+			}
+
+			if _, err := u.repo.EnqueueWebhookDeliveries(ctx, event, body); err != nil {
+				return err
+			}
+		}
+
+		return u.repo.UpdateWebhookCursor(ctx, events[len(events)-1].Sequence)
+	})
+	if err != nil {
+		return false, fmt.Errorf("usecase enqueue webhook deliveries: %w", err)
+	}
+
+	return len(events) == u.batchSize, nil
+}
+
+// deliver makes an attempt of the delivery due first. The delivery is
+// claimed for the lease and the outcome is recorded separately, so no
+// transaction is held while the webhook is called. A delivery of a stopped
+// dispatcher is attempted again once the lease ends. Failed attempts are
+// scheduled again with exponential backoff until the attempts run out.
+func (u *Usecase) deliver(ctx context.Context) (bool, error) {
+	d, w, err := u.repo.ClaimWebhookDelivery(ctx, u.lease)
+	if err != nil {
+		if errors.Is(err, entity.ErrWebhookDeliveryNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("usecase deliver webhook: %w", err)
+	}
+
+	res, err := u.sender.Send(ctx, webhook.Request{
+		URL:        w.URL,
+		Secret:     w.Secret,
+		Event:      d.EventType.String(),
+		DeliveryID: d.ID,
+		Body:       d.Payload,
+	})
+	if ctx.Err() != nil {
+		// The attempt is made again once the lease ends.
+		return false, nil
+	}
+
+	var retryAfter time.Duration
+	d.ResponseStatus = res.StatusCode
+	d.LastError = ""
+	d.Status = entity.WebhookDeliverySucceeded
+	if err != nil {
+		d.LastError = err.Error()
+		d.Status = entity.WebhookDeliveryFailed
+		if webhook.Retryable(res, err) && d.Attempts < u.retryAttempts {
+			d.Status = entity.WebhookDeliveryPending
+			retryAfter = u.backoff(d.Attempts)
+		}
+
+		slogx.Warn(ctx, "webhook delivery attempt failed",
+			slogx.Err(err),
+			slog.Int64("delivery_id", d.ID),
+			slog.Int64("webhook_id", d.WebhookID),
+			slog.Int("attempt", d.Attempts),
+			slog.Duration("retry_after", retryAfter),
+		)
+	}
+
+	if err := u.repo.RecordWebhookDeliveryAttempt(ctx, d, retryAfter); err != nil {
+		if errors.Is(err, entity.ErrWebhookDeliveryNotFound) {
+			slogx.Warn(ctx, "webhook delivery deleted or claimed again before the attempt was recorded",
+				slog.Int64("delivery_id", d.ID),
+			)
+			return true, nil
+		}
+		return false, fmt.Errorf("usecase deliver webhook: %w", err)
+	}
+
+	return true, nil
+}
+
+// backoff returns the delay after the failed attempt, it doubles with every
+// attempt from retryDelay, gets a random jitter up to retryMaxJitter and
+// is bounded by retryMaxDelay.
+func (u *Usecase) backoff(attempts int) time.Duration {
+	// BackOffDelay caches its state in the config, so it is not shared.
+	config := &retry.Config{}
+	for _, opt := range []retry.Option{
+		retry.Delay(u.retryDelay),
+		retry.MaxJitter(u.retryMaxJitter),
+	} {
+		opt(config)
+	}
+
+	delay := retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)(uint(attempts), nil, config)
+
+	return min(delay, u.retryMaxDelay)
+}
+
+func eventToPayload(event entity.NoteEvent) payload {
+	p := payload{
+		Sequence:  event.Sequence,
+		Event:     event.Type.String(),
+		CreatedAt: event.CreatedAt,
+		Note: notePayload{
+			ID:         event.Note.ID,
+			UserID:     event.Note.UserID,
+			Title:      event.Note.Title,
+			Content:    event.Note.Content,
+			Tags:       event.Note.Tags,
+			NotebookID: event.Note.NotebookID,
+			Revision:   event.Note.Revision,
+			CreatedAt:  event.Note.CreatedAt,
+			UpdatedAt:  event.Note.UpdatedAt,
+		},
+	}
+	if p.Note.Tags == nil {
+		p.Note.Tags = []string{}
+	}
+	if !event.Note.DeletedAt.IsZero() {
+		p.Note.DeletedAt = &event.Note.DeletedAt
+	}
+	if event.Type == entity.NoteEventShared {
+		p.Collaborator = &collaboratorPayload{
+			UserID:    event.Collaborator.UserID,
+			Role:      event.Collaborator.Role.String(),
+			CreatedAt: event.Collaborator.CreatedAt,
+		}
+	}
+	if event.Type == entity.NoteEventPresenceJoined || event.Type == entity.NoteEventPresenceLeft {
+		p.Presence = &presencePayload{
+			UserID:   event.Presence.UserID,
+			Activity: event.Presence.Activity.String(),
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,226 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/logger/slogx"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/webhook"
+)
+
+func TestMain(m *testing.M) {
+	slogx.SetDefault(slogx.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+// fakeRepo hands out a single delivery and keeps the recorded attempt.
+type fakeRepo struct {
+	webhooksRepository
+
+	delivery entity.WebhookDelivery
+	webhook  entity.Webhook
+	claimed  bool
+
+	recorded   *entity.WebhookDelivery
+	retryAfter time.Duration
+}
+
+func (r *fakeRepo) ClaimWebhookDelivery(context.Context, time.Duration) (entity.WebhookDelivery, entity.Webhook, error) {
+	if r.claimed {
+		return entity.WebhookDelivery{}, entity.Webhook{}, entity.ErrWebhookDeliveryNotFound
+	}
+	r.claimed = true
+
+	return r.delivery, r.webhook, nil
+}
+
+func (r *fakeRepo) RecordWebhookDeliveryAttempt(_ context.Context, d entity.WebhookDelivery, retryAfter time.Duration) error {
+	r.recorded = &d
+	r.retryAfter = retryAfter
+
+	return nil
+}
+
+type noTx struct{}
+
+func (noTx) RunInTx(ctx context.Context, f func(context.Context) error) error { return f(ctx) }
+
+func newUsecase(t *testing.T, repo webhooksRepository) *Usecase {
+	t.Helper()
+
+	s, err := webhook.NewSender(webhook.NewOptions(
+		webhook.WithTimeout(time.Second),
+		webhook.WithAllowPrivateAddresses(true),
+	))
+	if err != nil {
+		t.Fatalf("NewSender() error = %v", err)
+	}
+
+	u, err := New(NewOptions(repo, noTx{}, s,
+		WithRetryAttempts(3),
+		WithRetryDelay(time.Second),
+		WithRetryMaxDelay(time.Minute),
+		WithRetryMaxJitter(testMaxJitter),
+	))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return u
+}
+
+func TestDeliver(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		attempts       int
+		wantStatus     entity.WebhookDeliveryStatus
+		wantRetryAfter time.Duration
+		wantError      bool
+	}{
+		{
+			name:       "succeeded",
+			status:     http.StatusOK,
+			attempts:   1,
+			wantStatus: entity.WebhookDeliverySucceeded,
+		},
+		{
+			name:           "retried",
+			status:         http.StatusServiceUnavailable,
+			attempts:       1,
+			wantStatus:     entity.WebhookDeliveryPending,
+			wantRetryAfter: time.Second,
+			wantError:      true,
+		},
+		{
+			name:           "retried with backoff",
+			status:         http.StatusTooManyRequests,
+			attempts:       2,
+			wantStatus:     entity.WebhookDeliveryPending,
+			wantRetryAfter: 2 * time.Second,
+			wantError:      true,
+		},
+		{
+			name:       "attempts run out",
+			status:     http.StatusServiceUnavailable,
+			attempts:   3,
+			wantStatus: entity.WebhookDeliveryFailed,
+			wantError:  true,
+		},
+		{
+			name:       "not retryable",
+			status:     http.StatusBadRequest,
+			attempts:   1,
+			wantStatus: entity.WebhookDeliveryFailed,
+			wantError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := []byte(`{"sequence":7}`)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if !webhook.Verify("secret", body, r.Header.Get(webhook.SignatureHeader)) {
+					t.Errorf("%s = %q does not verify", webhook.SignatureHeader, r.Header.Get(webhook.SignatureHeader))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			repo := &fakeRepo{
+				delivery: entity.WebhookDelivery{
+					ID:        1,
+					WebhookID: 2,
+					EventType: entity.NoteEventCreated,
+					Payload:   payload,
+					Status:    entity.WebhookDeliveryPending,
+					Attempts:  tt.attempts,
+				},
+				webhook: entity.Webhook{ID: 2, URL: srv.URL, Secret: "secret"},
+			}
+
+			u := newUsecase(t, repo)
+
+			busy, err := u.deliver(context.Background())
+			if err != nil || !busy {
+				t.Fatalf("deliver() = %v, %v, want true, nil", busy, err)
+			}
+
+			d := repo.recorded
+			if d == nil {
+				t.Fatal("attempt is not recorded")
+			}
+			if d.Status != tt.wantStatus {
+				t.Errorf("Status = %v, want %v", d.Status, tt.wantStatus)
+			}
+			if d.ResponseStatus != tt.status {
+				t.Errorf("ResponseStatus = %d, want %d", d.ResponseStatus, tt.status)
+			}
+			if (d.LastError != "") != tt.wantError {
+				t.Errorf("LastError = %q, want error %v", d.LastError, tt.wantError)
+			}
+			if !withinJitter(repo.retryAfter, tt.wantRetryAfter) {
+				t.Errorf("retryAfter = %v, want %v with jitter up to %v", repo.retryAfter, tt.wantRetryAfter, testMaxJitter)
+			}
+
+			busy, err = u.deliver(context.Background())
+			if err != nil || busy {
+				t.Errorf("deliver() without due deliveries = %v, %v, want false, nil", busy, err)
+			}
+		})
+	}
+}
+
+const testMaxJitter = 100 * time.Millisecond
+
+// withinJitter reports whether the delay is the expected one with a jitter,
+// no delay means the delivery is not retried.
+func withinJitter(delay, expected time.Duration) bool {
+	if expected == 0 {
+		return delay == 0
+	}
+
+	return delay >= expected && delay < expected+testMaxJitter
+}
+
+func TestBackoff(t *testing.T) {
+	u := &Usecase{Options: Options{
+		retryDelay:     time.Second,
+		retryMaxDelay:  10 * time.Second,
+		retryMaxJitter: testMaxJitter,
+	}}
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Second},
+		{attempts: 2, expected: 2 * time.Second},
+		{attempts: 3, expected: 4 * time.Second},
+		{attempts: 4, expected: 8 * time.Second},
+		{attempts: 5, expected: 10 * time.Second},
+		{attempts: 20, expected: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		got := u.backoff(tt.attempts)
+		if tt.expected == u.retryMaxDelay {
+			if got != tt.expected {
+				t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.expected)
+			}
+			continue
+		}
+		if !withinJitter(got, tt.expected) {
+			t.Errorf("backoff(%d) = %v, want %v with jitter up to %v", tt.attempts, got, tt.expected, testMaxJitter)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/webhook"
+)
+
+const defaultDeliveriesPageSize = 50
+
+// anyUser matches webhooks of every user in the repository lookups.
+const anyUser = 0
+
+type webhooksRepository interface {
+	CreateWebhook(ctx context.Context, w entity.Webhook) (entity.Webhook, error)
+	GetWebhook(ctx context.Context, userID, id int64) (entity.Webhook, error)
+	ListWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, id int64) error
+	ListWebhookDeliveries(ctx context.Context, userID, webhookID int64, limit int) ([]entity.WebhookDelivery, error)
+	RedeliverWebhookDelivery(ctx context.Context, userID, webhookID, id int64) (entity.WebhookDelivery, error)
+
+	LockWebhookCursor(ctx context.Context) (int64, error)
+	UpdateWebhookCursor(ctx context.Context, sequence int64) error
+	ListNoteEventsAfter(ctx context.Context, after int64, limit int) ([]entity.NoteEvent, error)
+	EnqueueWebhookDeliveries(ctx context.Context, event entity.NoteEvent, payload []byte) (int64, error)
+	ClaimWebhookDelivery(ctx context.Context, lease time.Duration) (entity.WebhookDelivery, entity.Webhook, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, d entity.WebhookDelivery, retryAfter time.Duration) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+type sender interface {
+	Send(ctx context.Context, req webhook.Request) (webhook.Result, error)
+	ValidateURL(ctx context.Context, rawURL string) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	repo   webhooksRepository `option:"mandatory" validate:"required"`
+	tx     transactor         `option:"mandatory" validate:"required"`
+	sender sender             `option:"mandatory" validate:"required"`
+
+	// interval is the pause of the dispatcher when there is nothing to do.
+	interval  time.Duration `default:"1s" validate:"min=10ms"`
+	workers   int           `default:"4" validate:"min=1,max=64"`
+	batchSize int           `default:"500" validate:"min=1,max=10000"`
+
+	// lease is how long a claimed delivery waits for its attempt to be
+	// recorded before another dispatcher attempts it, it must be longer
+	// than the sender timeout.
+	lease time.Duration `default:"1m" validate:"min=1s"`
+	// retryAttempts bounds the attempts of a delivery, the delay between
+	// them doubles from retryDelay up to retryMaxDelay.
+	retryAttempts int           `default:"5" validate:"min=1,max=20"`
+	retryDelay    time.Duration `default:"1s" validate:"min=10ms"`
+	retryMaxDelay time.Duration `default:"1h" validate:"min=10ms"`
+	// retryMaxJitter bounds the random delay added to the backoff, so
+	// deliveries that failed together are not retried at once.
+	retryMaxJitter time.Duration `default:"1s" validate:"min=1ms"`
+}
+
+type Usecase struct {
+	Options
+}
+
+func New(opts Options) (*Usecase, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate webhooks usecase options: %v", err)
+	}
+
+	return &Usecase{Options: opts}, nil
+}
+
+func (u *Usecase) CreateWebhook(ctx context.Context, w entity.Webhook) (entity.Webhook, error) {
+	if err := u.sender.ValidateURL(ctx, w.URL); err != nil {
+		return entity.Webhook{}, fmt.Errorf("usecase create webhook: %w: %v", entity.ErrInvalidWebhookURL, err)
+	}
+
+	w, err := u.repo.CreateWebhook(ctx, w)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("usecase create webhook: %w", err)
+	}
+
+	return w, nil
+}
+
+func (u *Usecase) ListWebhooks(ctx context.Context, userID int64) ([]entity.Webhook, error) {
+	webhooks, err := u.repo.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook deletes the webhook with its delivery log, a delivery
+// in progress is completed.
+func (u *Usecase) DeleteWebhook(ctx context.Context, userID, id int64) error {
+	if err := u.repo.DeleteWebhook(ctx, userID, id); err != nil {
+		return fmt.Errorf("usecase delete webhook: %w", err)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of the webhook,
+// newest first.
+func (u *Usecase) ListWebhookDeliveries(ctx context.Context, userID, webhookID int64, pageSize int) ([]entity.WebhookDelivery, error) {
+	if pageSize == 0 {
+		pageSize = defaultDeliveriesPageSize
+	}
+
+	if _, err := u.repo.GetWebhook(ctx, userID, webhookID); err != nil {
+		return nil, fmt.Errorf("usecase list webhook deliveries: %w", err)
+	}
+
+	deliveries, err := u.repo.ListWebhookDeliveries(ctx, userID, webhookID, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// AdminListWebhookDeliveries is ListWebhookDeliveries for a webhook
+// of any user.
+func (u *Usecase) AdminListWebhookDeliveries(ctx context.Context, webhookID int64, pageSize int) ([]entity.WebhookDelivery, error) {
+	return u.ListWebhookDeliveries(ctx, anyUser, webhookID, pageSize)
+}
+
+// RedeliverWebhook enqueues the payload of the delivery once again,
+// the original delivery is kept in the log as is.
+func (u *Usecase) RedeliverWebhook(ctx context.Context, userID, webhookID, deliveryID int64) (entity.WebhookDelivery, error) {
+	d, err := u.repo.RedeliverWebhookDelivery(ctx, userID, webhookID, deliveryID)
+	if err != nil {
+		return entity.WebhookDelivery{}, fmt.Errorf("usecase redeliver webhook: %w", err)
+	}
+
+	return d, nil
+}
+
+// AdminRedeliverWebhook is RedeliverWebhook for a webhook of any user.
+func (u *Usecase) AdminRedeliverWebhook(ctx context.Context, webhookID, deliveryID int64) (entity.WebhookDelivery, error) {
+	return u.RedeliverWebhook(ctx, anyUser, webhookID, deliveryID)
+}
@@ -0,0 +1,175 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package webhooks
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	repo webhooksRepository,
+	tx transactor,
+	sender sender,
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.interval, _ = time.ParseDuration("1s")
+	o.workers = 4
+	o.batchSize = 500
+	o.lease, _ = time.ParseDuration("1m")
+	o.retryAttempts = 5
+	o.retryDelay, _ = time.ParseDuration("1s")
+	o.retryMaxDelay, _ = time.ParseDuration("1h")
+	o.retryMaxJitter, _ = time.ParseDuration("1s")
+
+	o.repo = repo
+	o.tx = tx
+	o.sender = sender
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// interval is the pause of the dispatcher when there is nothing to do.
+func WithInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.interval = opt }
+}
+
+func WithWorkers(opt int) OptOptionsSetter {
+	return func(o *Options) { o.workers = opt }
+}
+
+func WithBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) { o.batchSize = opt }
+}
+
+// lease is how long a claimed delivery waits for its attempt to be
+// recorded before another dispatcher attempts it, it must be longer
+// than the sender timeout.
+func WithLease(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.lease = opt }
+}
+
+// retryAttempts bounds the attempts of a delivery, the delay between
+// them doubles from retryDelay up to retryMaxDelay.
+func WithRetryAttempts(opt int) OptOptionsSetter {
+	return func(o *Options) { o.retryAttempts = opt }
+}
+
+func WithRetryDelay(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.retryDelay = opt }
+}
+
+func WithRetryMaxDelay(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.retryMaxDelay = opt }
+}
+
+// retryMaxJitter bounds the random delay added to the backoff, so
+// deliveries that failed together are not retried at once.
+func WithRetryMaxJitter(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.retryMaxJitter = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("repo", _validate_Options_repo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("tx", _validate_Options_tx(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sender", _validate_Options_sender(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("interval", _validate_Options_interval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("workers", _validate_Options_workers(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("batchSize", _validate_Options_batchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("lease", _validate_Options_lease(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retryAttempts", _validate_Options_retryAttempts(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retryDelay", _validate_Options_retryDelay(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retryMaxDelay", _validate_Options_retryMaxDelay(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("retryMaxJitter", _validate_Options_retryMaxJitter(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_repo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.repo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `repo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_tx(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.tx, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `tx` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_sender(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sender, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sender` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_interval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.interval, "min=10ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `interval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_workers(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.workers, "min=1,max=64"); err != nil {
+		return fmt461e464ebed9.Errorf("field `workers` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_batchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.batchSize, "min=1,max=10000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `batchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_lease(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.lease, "min=1s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `lease` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retryAttempts(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retryAttempts, "min=1,max=20"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retryAttempts` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retryDelay(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retryDelay, "min=10ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retryDelay` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retryMaxDelay(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retryMaxDelay, "min=10ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retryMaxDelay` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_retryMaxJitter(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.retryMaxJitter, "min=1ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `retryMaxJitter` did not pass the test: %w", err)
+	}
+	return nil
+}
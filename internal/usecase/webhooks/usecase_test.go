@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/evgeniy-krivenko/grpc-notes/internal/entity"
+)
+
+type createRepo struct {
+	webhooksRepository
+	created bool
+}
+
+func (r *createRepo) CreateWebhook(_ context.Context, w entity.Webhook) (entity.Webhook, error) {
+	r.created = true
+	return w, nil
+}
+
+func TestCreateWebhookValidatesURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr error
+	}{
+		{name: "local address allowed in development", url: "http://127.0.0.1:8080/hook"},
+		{name: "not http", url: "file:///etc/passwd", wantErr: entity.ErrInvalidWebhookURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &createRepo{}
+			u := newUsecase(t, repo)
+
+			_, err := u.CreateWebhook(context.Background(), entity.Webhook{UserID: 1, URL: tt.url})
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("CreateWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+			if repo.created != (tt.wantErr == nil) {
+				t.Errorf("webhook created = %v, want %v", repo.created, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+// ownedRepo keeps webhook 10 of user 1 with delivery 100, lookups by
+// user 0 match webhooks of any user as in the repository.
+type ownedRepo struct {
+	webhooksRepository
+}
+
+func (ownedRepo) owns(userID, webhookID int64) bool {
+	return webhookID == 10 && (userID == anyUser || userID == 1)
+}
+
+func (r ownedRepo) GetWebhook(_ context.Context, userID, id int64) (entity.Webhook, error) {
+	if !r.owns(userID, id) {
+		return entity.Webhook{}, entity.ErrWebhookNotFound
+	}
+
+	return entity.Webhook{ID: id, UserID: 1}, nil
+}
+
+func (r ownedRepo) ListWebhookDeliveries(_ context.Context, userID, webhookID int64, _ int) ([]entity.WebhookDelivery, error) {
+	if !r.owns(userID, webhookID) {
+		return nil, nil
+	}
+
+	return []entity.WebhookDelivery{{ID: 100, WebhookID: webhookID}}, nil
+}
+
+func (r ownedRepo) RedeliverWebhookDelivery(_ context.Context, userID, webhookID, id int64) (entity.WebhookDelivery, error) {
+	if !r.owns(userID, webhookID) || id != 100 {
+		return entity.WebhookDelivery{}, entity.ErrWebhookDeliveryNotFound
+	}
+
+	return entity.WebhookDelivery{ID: 101, WebhookID: webhookID, Status: entity.WebhookDeliveryPending}, nil
+}
+
+func TestWebhookDeliveriesAccess(t *testing.T) {
+	u := newUsecase(t, ownedRepo{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		list         func() ([]entity.WebhookDelivery, error)
+		redeliver    func() (entity.WebhookDelivery, error)
+		wantListErr  error
+		wantRedelErr error
+	}{
+		{
+			name:      "owner",
+			list:      func() ([]entity.WebhookDelivery, error) { return u.ListWebhookDeliveries(ctx, 1, 10, 0) },
+			redeliver: func() (entity.WebhookDelivery, error) { return u.RedeliverWebhook(ctx, 1, 10, 100) },
+		},
+		{
+			name:         "other user",
+			list:         func() ([]entity.WebhookDelivery, error) { return u.ListWebhookDeliveries(ctx, 2, 10, 0) },
+			redeliver:    func() (entity.WebhookDelivery, error) { return u.RedeliverWebhook(ctx, 2, 10, 100) },
+			wantListErr:  entity.ErrWebhookNotFound,
+			wantRedelErr: entity.ErrWebhookDeliveryNotFound,
+		},
+		{
+			name:      "admin",
+			list:      func() ([]entity.WebhookDelivery, error) { return u.AdminListWebhookDeliveries(ctx, 10, 0) },
+			redeliver: func() (entity.WebhookDelivery, error) { return u.AdminRedeliverWebhook(ctx, 10, 100) },
+		},
+		{
+			name:         "admin, unknown webhook",
+			list:         func() ([]entity.WebhookDelivery, error) { return u.AdminListWebhookDeliveries(ctx, 11, 0) },
+			redeliver:    func() (entity.WebhookDelivery, error) { return u.AdminRedeliverWebhook(ctx, 11, 100) },
+			wantListErr:  entity.ErrWebhookNotFound,
+			wantRedelErr: entity.ErrWebhookDeliveryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deliveries, err := tt.list()
+			if !errors.Is(err, tt.wantListErr) || (err != nil) != (tt.wantListErr != nil) {
+				t.Errorf("list error = %v, want %v", err, tt.wantListErr)
+			}
+			if err == nil && len(deliveries) != 1 {
+				t.Errorf("deliveries = %v, want one", deliveries)
+			}
+
+			d, err := tt.redeliver()
+			if !errors.Is(err, tt.wantRedelErr) || (err != nil) != (tt.wantRedelErr != nil) {
+				t.Errorf("redeliver error = %v, want %v", err, tt.wantRedelErr)
+			}
+			if err == nil && d.ID != 101 {
+				t.Errorf("redelivery = %+v, want delivery 101", d)
+			}
+		})
+	}
+}
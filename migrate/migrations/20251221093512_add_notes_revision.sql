@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table notes add column revision bigint not null default 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table notes drop column revision;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create index idx_notes_user_id_created_at on notes(user_id, created_at, id);
+create index idx_notes_user_id_updated_at on notes(user_id, updated_at, id);
+create index idx_notes_user_id_title on notes(user_id, title, id);
+
+drop index if exists idx_notes_user_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create index idx_notes_user_id on notes(user_id);
+
+drop index if exists idx_notes_user_id_title;
+drop index if exists idx_notes_user_id_updated_at;
+drop index if exists idx_notes_user_id_created_at;
+-- +goose StatementEnd
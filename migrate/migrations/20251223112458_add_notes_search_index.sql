@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+create function notes_search_vector(title varchar, content text) returns tsvector
+    language sql
+    immutable
+    parallel safe
+as $$
+select setweight(to_tsvector('simple'::regconfig, title), 'A') ||
+       setweight(to_tsvector('english'::regconfig, title), 'A') ||
+       setweight(to_tsvector('russian'::regconfig, title), 'A') ||
+       setweight(to_tsvector('simple'::regconfig, content), 'B') ||
+       setweight(to_tsvector('english'::regconfig, content), 'B') ||
+       setweight(to_tsvector('russian'::regconfig, content), 'B')
+$$;
+
+create index idx_notes_search on notes using gin (notes_search_vector(title, content));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_notes_search;
+drop function if exists notes_search_vector(varchar, text);
+-- +goose StatementEnd
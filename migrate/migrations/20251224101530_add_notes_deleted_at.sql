@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table notes add column deleted_at timestamptz;
+
+create index idx_notes_user_id_deleted_at on notes(user_id, deleted_at, id) where deleted_at is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_notes_user_id_deleted_at;
+
+alter table notes drop column deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_revisions (
+    note_id    bigint      not null references notes(id) on delete cascade,
+    revision   bigint      not null,
+    author_id  bigint      not null,
+    title      varchar     not null,
+    content    text        not null,
+    created_at timestamptz not null default now(),
+    primary key (note_id, revision)
+);
+
+insert into note_revisions (note_id, revision, author_id, title, content, created_at)
+select id, revision, user_id, title, content, updated_at
+from notes;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_revisions;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists tags (
+    id         bigserial primary key,
+    user_id    bigint      not null,
+    name       varchar     not null,
+    created_at timestamptz not null default now(),
+    unique (user_id, name)
+);
+
+create table if not exists note_tags (
+    note_id bigint not null references notes(id) on delete cascade,
+    tag_id  bigint not null references tags(id) on delete cascade,
+    primary key (note_id, tag_id)
+);
+
+create index idx_note_tags_tag_id on note_tags(tag_id, note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_tags;
+
+drop table if exists tags;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists notebooks (
+    id         bigserial primary key,
+    user_id    bigint      not null,
+    parent_id  bigint references notebooks(id) on delete cascade,
+    name       varchar     not null,
+    -- materialized path of ids from the root, e.g. /1/5/
+    path       text        not null,
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now()
+);
+
+create index idx_notebooks_user_id_path on notebooks(user_id, path text_pattern_ops);
+create index idx_notebooks_parent_id on notebooks(parent_id);
+
+alter table notes add column notebook_id bigint references notebooks(id) on delete set null;
+
+create index idx_notes_notebook_id on notes(notebook_id) where notebook_id is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_notes_notebook_id;
+
+alter table notes drop column notebook_id;
+
+drop table if exists notebooks;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_collaborators (
+    note_id         bigint      not null references notes(id) on delete cascade,
+    collaborator_id bigint      not null,
+    role            varchar     not null check (role in ('viewer', 'editor', 'owner')),
+    created_at      timestamptz not null default now(),
+    primary key (note_id, collaborator_id)
+);
+
+create index idx_note_collaborators_collaborator_id on note_collaborators(collaborator_id, note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_collaborators;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists share_links (
+    id            bigserial primary key,
+    note_id       bigint      not null references notes(id) on delete cascade,
+    -- sha-256 of the token, the token itself is shown only once
+    token_hash    bytea       not null unique,
+    password_hash varchar,
+    expires_at    timestamptz,
+    revoked_at    timestamptz,
+    created_by    bigint      not null,
+    created_at    timestamptz not null default now()
+);
+
+create index idx_share_links_note_id on share_links(note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists share_links;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists attachments (
+    id           bigserial primary key,
+    -- set to null when the note is purged, the blob is removed afterwards
+    note_id      bigint      references notes(id) on delete set null,
+    storage_key  varchar     not null unique,
+    filename     varchar     not null,
+    content_type varchar     not null,
+    size         bigint      not null,
+    sha256       bytea       not null,
+    created_by   bigint      not null,
+    created_at   timestamptz not null default now()
+);
+
+create index idx_attachments_note_id on attachments(note_id);
+create index idx_attachments_orphaned on attachments(id) where note_id is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists attachments;
+-- +goose StatementEnd
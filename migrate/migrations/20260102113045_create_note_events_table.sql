@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_events (
+    sequence   bigserial primary key,
+    type       varchar     not null check (type in ('created', 'updated', 'deleted', 'restored', 'shared')),
+    -- no foreign key, events outlive purged notes
+    note_id    bigint      not null,
+    recipients bigint[]    not null,
+    payload    jsonb       not null,
+    created_at timestamptz not null default now()
+);
+
+create index idx_note_events_recipients on note_events using gin (recipients);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_events;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists webhooks (
+    id          bigserial primary key,
+    user_id     bigint      not null,
+    url         varchar     not null,
+    secret      varchar     not null,
+    -- empty subscribes to every event type
+    event_types varchar[]   not null default '{}',
+    created_at  timestamptz not null default now()
+);
+
+create index idx_webhooks_user_id on webhooks(user_id);
+
+create table if not exists webhook_deliveries (
+    id              bigserial primary key,
+    webhook_id      bigint      not null references webhooks(id) on delete cascade,
+    event_sequence  bigint      not null,
+    event_type      varchar     not null,
+    payload         jsonb       not null,
+    status          varchar     not null default 'pending' check (status in ('pending', 'succeeded', 'failed')),
+    attempts        int         not null default 0,
+    response_status int,
+    last_error      varchar,
+    created_at      timestamptz not null default now(),
+    completed_at    timestamptz
+);
+
+create index idx_webhook_deliveries_webhook_id on webhook_deliveries(webhook_id, id desc);
+create index idx_webhook_deliveries_pending on webhook_deliveries(id) where status = 'pending';
+
+-- position of the dispatcher in the note event log, a single row
+create table if not exists webhook_cursor (
+    id       boolean primary key default true check (id),
+    sequence bigint  not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists webhook_cursor;
+drop table if exists webhook_deliveries;
+drop table if exists webhooks;
+-- +goose StatementEnd
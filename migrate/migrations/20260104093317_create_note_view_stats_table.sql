@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_view_stats (
+    note_id bigint      not null references notes(id) on delete cascade,
+    -- start of the hour the views were counted in
+    bucket  timestamptz not null,
+    views   bigint      not null,
+    primary key (note_id, bucket)
+);
+
+create index idx_note_view_stats_bucket on note_view_stats(bucket);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_view_stats;
+-- +goose StatementEnd
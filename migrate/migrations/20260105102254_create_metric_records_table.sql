@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- received UploadMetrics records, the key deduplicates retried uploads
+create table if not exists metric_records (
+    user_id     bigint      not null,
+    batch_id    uuid        not null,
+    sequence    bigint      not null,
+    note_id     bigint      not null,
+    bucket      timestamptz not null,
+    views       bigint      not null,
+    received_at timestamptz not null default now(),
+    primary key (user_id, batch_id, sequence)
+);
+
+create index idx_metric_records_received_at on metric_records(received_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists metric_records;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists chat_messages (
+    id         bigserial primary key,
+    note_id    bigint      not null references notes (id) on delete cascade,
+    user_id    bigint      not null,
+    content    text        not null,
+    created_at timestamptz not null default now()
+);
+
+create index idx_chat_messages_note_id on chat_messages (note_id, id desc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chat_messages;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_documents (
+    note_id          bigint primary key references notes (id) on delete cascade,
+    content          text        not null,
+    version          bigint      not null,
+    -- note revision the content was last loaded from or saved as
+    note_revision    bigint      not null,
+    -- version saved to the note, the document is dirty when it is behind
+    snapshot_version bigint      not null,
+    last_editor_id   bigint      not null,
+    updated_at       timestamptz not null default now()
+);
+
+create index idx_note_documents_dirty on note_documents (updated_at) where version > snapshot_version;
+
+create table if not exists note_edits (
+    id            bigserial primary key,
+    note_id       bigint      not null references notes (id) on delete cascade,
+    session_id    uuid        not null,
+    user_id       bigint      not null,
+    kind          varchar     not null check (kind in ('operation', 'cursor', 'leave')),
+    -- version produced by the operation or the cursor refers to
+    version       bigint      not null,
+    operation     jsonb,
+    position      bigint,
+    selection_end bigint,
+    created_at    timestamptz not null default now()
+);
+
+create unique index idx_note_edits_operations on note_edits (note_id, version) where kind = 'operation';
+create index idx_note_edits_sessions on note_edits (note_id, session_id, id desc) where kind <> 'operation';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists note_edits;
+drop table if exists note_documents;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists note_presence (
+    note_id    bigint      not null references notes (id) on delete cascade,
+    session_id uuid        not null,
+    user_id    bigint      not null,
+    activity   varchar     not null check (activity in ('viewing', 'chatting', 'editing')),
+    joined_at  timestamptz not null default now(),
+    expires_at timestamptz not null,
+    primary key (note_id, session_id)
+);
+
+create index idx_note_presence_session_id on note_presence (session_id);
+create index idx_note_presence_expires_at on note_presence (expires_at);
+
+alter table note_events drop constraint if exists note_events_type_check;
+alter table note_events add constraint note_events_type_check
+    check (type in ('created', 'updated', 'deleted', 'restored', 'shared', 'presence_joined', 'presence_left'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from note_events where type in ('presence_joined', 'presence_left');
+
+alter table note_events drop constraint if exists note_events_type_check;
+alter table note_events add constraint note_events_type_check
+    check (type in ('created', 'updated', 'deleted', 'restored', 'shared'));
+
+drop table if exists note_presence;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- purging a note scrubs its events
+create index if not exists idx_note_events_note_id on note_events(note_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_note_events_note_id;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- pending deliveries are attempted once due, a claimed delivery is leased
+-- by moving it into the future
+alter table webhook_deliveries add column if not exists next_attempt_at timestamptz not null default now();
+
+drop index if exists idx_webhook_deliveries_pending;
+create index idx_webhook_deliveries_pending on webhook_deliveries(next_attempt_at, id) where status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_webhook_deliveries_pending;
+create index idx_webhook_deliveries_pending on webhook_deliveries(id) where status = 'pending';
+
+alter table webhook_deliveries drop column if exists next_attempt_at;
+-- +goose StatementEnd
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: api/notes/v1/auth.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuthPolicy restricts authenticated calls of a method by the token claims.
+type AuthPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The token needs one of the roles, empty allows any role.
+	Roles []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The token needs all of the scopes. Tokens without the scope claim
+	// are granted none.
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *AuthPolicy) Reset() {
+	*x = AuthPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_notes_v1_auth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicy) ProtoMessage() {}
+
+func (x *AuthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notes_v1_auth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicy.ProtoReflect.Descriptor instead.
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return file_api_notes_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthPolicy) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AuthPolicy) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+var file_api_notes_v1_auth_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AuthPolicy)(nil),
+		Field:         50001,
+		Name:          "auth_policy",
+		Tag:           "bytes,50001,opt,name=auth_policy",
+		Filename:      "api/notes/v1/auth.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional AuthPolicy auth_policy = 50001;
+	E_AuthPolicy = &file_api_notes_v1_auth_proto_extTypes[0]
+)
+
+var File_api_notes_v1_auth_proto protoreflect.FileDescriptor
+
+var file_api_notes_v1_auth_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3a, 0x0a, 0x0a, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x3a, 0x4e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79, 0x2d, 0x6b, 0x72,
+	0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_notes_v1_auth_proto_rawDescOnce sync.Once
+	file_api_notes_v1_auth_proto_rawDescData = file_api_notes_v1_auth_proto_rawDesc
+)
+
+func file_api_notes_v1_auth_proto_rawDescGZIP() []byte {
+	file_api_notes_v1_auth_proto_rawDescOnce.Do(func() {
+		file_api_notes_v1_auth_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_notes_v1_auth_proto_rawDescData)
+	})
+	return file_api_notes_v1_auth_proto_rawDescData
+}
+
+var file_api_notes_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_notes_v1_auth_proto_goTypes = []interface{}{
+	(*AuthPolicy)(nil),                 // 0: AuthPolicy
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_api_notes_v1_auth_proto_depIdxs = []int32{
+	1, // 0: auth_policy:extendee -> google.protobuf.MethodOptions
+	0, // 1: auth_policy:type_name -> AuthPolicy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_notes_v1_auth_proto_init() }
+func file_api_notes_v1_auth_proto_init() {
+	if File_api_notes_v1_auth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_notes_v1_auth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_notes_v1_auth_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_notes_v1_auth_proto_goTypes,
+		DependencyIndexes: file_api_notes_v1_auth_proto_depIdxs,
+		MessageInfos:      file_api_notes_v1_auth_proto_msgTypes,
+		ExtensionInfos:    file_api_notes_v1_auth_proto_extTypes,
+	}.Build()
+	File_api_notes_v1_auth_proto = out.File
+	file_api_notes_v1_auth_proto_rawDesc = nil
+	file_api_notes_v1_auth_proto_goTypes = nil
+	file_api_notes_v1_auth_proto_depIdxs = nil
+}
@@ -23,8 +23,11 @@ const (
 type ErrorCode int32
 
 const (
-	ErrorCode_ERROR_CODE_NONE         ErrorCode = 0
-	ErrorCode_ERROR_CODE_INVALID_TEXT ErrorCode = 1
+	ErrorCode_ERROR_CODE_NONE                 ErrorCode = 0
+	ErrorCode_ERROR_CODE_INVALID_TEXT         ErrorCode = 1
+	ErrorCode_ERROR_CODE_REVISION_MISMATCH    ErrorCode = 2
+	ErrorCode_ERROR_CODE_PERMISSION_DENIED    ErrorCode = 3
+	ErrorCode_ERROR_CODE_ATTACHMENT_TOO_LARGE ErrorCode = 4
 )
 
 // Enum value maps for ErrorCode.
@@ -32,10 +35,16 @@ var (
 	ErrorCode_name = map[int32]string{
 		0: "ERROR_CODE_NONE",
 		1: "ERROR_CODE_INVALID_TEXT",
+		2: "ERROR_CODE_REVISION_MISMATCH",
+		3: "ERROR_CODE_PERMISSION_DENIED",
+		4: "ERROR_CODE_ATTACHMENT_TOO_LARGE",
 	}
 	ErrorCode_value = map[string]int32{
-		"ERROR_CODE_NONE":         0,
-		"ERROR_CODE_INVALID_TEXT": 1,
+		"ERROR_CODE_NONE":                 0,
+		"ERROR_CODE_INVALID_TEXT":         1,
+		"ERROR_CODE_REVISION_MISMATCH":    2,
+		"ERROR_CODE_PERMISSION_DENIED":    3,
+		"ERROR_CODE_ATTACHMENT_TOO_LARGE": 4,
 	}
 )
 
@@ -120,15 +129,22 @@ var file_api_notes_v1_errors_proto_rawDesc = []byte{
 	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x09, 0x4e,
 	0x6f, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
 	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0x3d, 0x0a, 0x09,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1b,
-	0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67, 0x65, 0x6e, 0x69,
-	0x79, 0x2d, 0x6b, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0xa6, 0x01, 0x0a,
+	0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x20,
+	0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x23, 0x0a, 0x1f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41,
+	0x52, 0x47, 0x45, 0x10, 0x04, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79, 0x2d, 0x6b, 0x72, 0x69, 0x76,
+	0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f,
+	0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
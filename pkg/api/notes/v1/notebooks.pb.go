@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: api/notes/v1/notebooks.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_api_notes_v1_notebooks_proto protoreflect.FileDescriptor
+
+var file_api_notes_v1_notebooks_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x61, 0x70, 0x69, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0xdd, 0x05, 0x0a, 0x0b, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x41, 0x50,
+	0x49, 0x12, 0x6c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x8a, 0xb5,
+	0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x6d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x13,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5,
+	0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x65,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x7a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x3a, 0x01, 0x2a, 0x32, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d,
+	0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x77, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65, 0x65, 0x12, 0x17,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x5a, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x74, 0x72, 0x65, 0x65, 0x12,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b,
+	0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x65,
+	0x65, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61,
+	0x64, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x65, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79, 0x2d, 0x6b, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_api_notes_v1_notebooks_proto_goTypes = []interface{}{
+	(*CreateNotebookRequest)(nil),   // 0: CreateNotebookRequest
+	(*GetNotebookRequest)(nil),      // 1: GetNotebookRequest
+	(*ListNotebooksRequest)(nil),    // 2: ListNotebooksRequest
+	(*UpdateNotebookRequest)(nil),   // 3: UpdateNotebookRequest
+	(*DeleteNotebookRequest)(nil),   // 4: DeleteNotebookRequest
+	(*GetNotebookTreeRequest)(nil),  // 5: GetNotebookTreeRequest
+	(*CreateNotebookResponse)(nil),  // 6: CreateNotebookResponse
+	(*GetNotebookResponse)(nil),     // 7: GetNotebookResponse
+	(*ListNotebooksResponse)(nil),   // 8: ListNotebooksResponse
+	(*UpdateNotebookResponse)(nil),  // 9: UpdateNotebookResponse
+	(*DeleteNotebookResponse)(nil),  // 10: DeleteNotebookResponse
+	(*GetNotebookTreeResponse)(nil), // 11: GetNotebookTreeResponse
+}
+var file_api_notes_v1_notebooks_proto_depIdxs = []int32{
+	0,  // 0: api.notest.v1.NotebookAPI.CreateNotebook:input_type -> CreateNotebookRequest
+	1,  // 1: api.notest.v1.NotebookAPI.GetNotebook:input_type -> GetNotebookRequest
+	2,  // 2: api.notest.v1.NotebookAPI.ListNotebooks:input_type -> ListNotebooksRequest
+	3,  // 3: api.notest.v1.NotebookAPI.UpdateNotebook:input_type -> UpdateNotebookRequest
+	4,  // 4: api.notest.v1.NotebookAPI.DeleteNotebook:input_type -> DeleteNotebookRequest
+	5,  // 5: api.notest.v1.NotebookAPI.GetNotebookTree:input_type -> GetNotebookTreeRequest
+	6,  // 6: api.notest.v1.NotebookAPI.CreateNotebook:output_type -> CreateNotebookResponse
+	7,  // 7: api.notest.v1.NotebookAPI.GetNotebook:output_type -> GetNotebookResponse
+	8,  // 8: api.notest.v1.NotebookAPI.ListNotebooks:output_type -> ListNotebooksResponse
+	9,  // 9: api.notest.v1.NotebookAPI.UpdateNotebook:output_type -> UpdateNotebookResponse
+	10, // 10: api.notest.v1.NotebookAPI.DeleteNotebook:output_type -> DeleteNotebookResponse
+	11, // 11: api.notest.v1.NotebookAPI.GetNotebookTree:output_type -> GetNotebookTreeResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_notes_v1_notebooks_proto_init() }
+func file_api_notes_v1_notebooks_proto_init() {
+	if File_api_notes_v1_notebooks_proto != nil {
+		return
+	}
+	file_api_notes_v1_messages_proto_init()
+	file_api_notes_v1_auth_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_notes_v1_notebooks_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_notes_v1_notebooks_proto_goTypes,
+		DependencyIndexes: file_api_notes_v1_notebooks_proto_depIdxs,
+	}.Build()
+	File_api_notes_v1_notebooks_proto = out.File
+	file_api_notes_v1_notebooks_proto_rawDesc = nil
+	file_api_notes_v1_notebooks_proto_goTypes = nil
+	file_api_notes_v1_notebooks_proto_depIdxs = nil
+}
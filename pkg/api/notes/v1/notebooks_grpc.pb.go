@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/notes/v1/notebooks.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NotebookAPIClient is the client API for NotebookAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotebookAPIClient interface {
+	CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error)
+	GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error)
+	ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error)
+	UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error)
+	DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error)
+	GetNotebookTree(ctx context.Context, in *GetNotebookTreeRequest, opts ...grpc.CallOption) (*GetNotebookTreeResponse, error)
+}
+
+type notebookAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotebookAPIClient(cc grpc.ClientConnInterface) NotebookAPIClient {
+	return &notebookAPIClient{cc}
+}
+
+func (c *notebookAPIClient) CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error) {
+	out := new(CreateNotebookResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/CreateNotebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookAPIClient) GetNotebook(ctx context.Context, in *GetNotebookRequest, opts ...grpc.CallOption) (*GetNotebookResponse, error) {
+	out := new(GetNotebookResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/GetNotebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookAPIClient) ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error) {
+	out := new(ListNotebooksResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/ListNotebooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookAPIClient) UpdateNotebook(ctx context.Context, in *UpdateNotebookRequest, opts ...grpc.CallOption) (*UpdateNotebookResponse, error) {
+	out := new(UpdateNotebookResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/UpdateNotebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookAPIClient) DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error) {
+	out := new(DeleteNotebookResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/DeleteNotebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notebookAPIClient) GetNotebookTree(ctx context.Context, in *GetNotebookTreeRequest, opts ...grpc.CallOption) (*GetNotebookTreeResponse, error) {
+	out := new(GetNotebookTreeResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NotebookAPI/GetNotebookTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotebookAPIServer is the server API for NotebookAPI service.
+// All implementations should embed UnimplementedNotebookAPIServer
+// for forward compatibility
+type NotebookAPIServer interface {
+	CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error)
+	GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error)
+	ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error)
+	UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error)
+	DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error)
+	GetNotebookTree(context.Context, *GetNotebookTreeRequest) (*GetNotebookTreeResponse, error)
+}
+
+// UnimplementedNotebookAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedNotebookAPIServer struct {
+}
+
+func (UnimplementedNotebookAPIServer) CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotebook not implemented")
+}
+func (UnimplementedNotebookAPIServer) GetNotebook(context.Context, *GetNotebookRequest) (*GetNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotebook not implemented")
+}
+func (UnimplementedNotebookAPIServer) ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotebooks not implemented")
+}
+func (UnimplementedNotebookAPIServer) UpdateNotebook(context.Context, *UpdateNotebookRequest) (*UpdateNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotebook not implemented")
+}
+func (UnimplementedNotebookAPIServer) DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotebook not implemented")
+}
+func (UnimplementedNotebookAPIServer) GetNotebookTree(context.Context, *GetNotebookTreeRequest) (*GetNotebookTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotebookTree not implemented")
+}
+
+// UnsafeNotebookAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotebookAPIServer will
+// result in compilation errors.
+type UnsafeNotebookAPIServer interface {
+	mustEmbedUnimplementedNotebookAPIServer()
+}
+
+func RegisterNotebookAPIServer(s grpc.ServiceRegistrar, srv NotebookAPIServer) {
+	s.RegisterService(&NotebookAPI_ServiceDesc, srv)
+}
+
+func _NotebookAPI_CreateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).CreateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/CreateNotebook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).CreateNotebook(ctx, req.(*CreateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotebookAPI_GetNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).GetNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/GetNotebook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).GetNotebook(ctx, req.(*GetNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotebookAPI_ListNotebooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotebooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).ListNotebooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/ListNotebooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).ListNotebooks(ctx, req.(*ListNotebooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotebookAPI_UpdateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).UpdateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/UpdateNotebook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).UpdateNotebook(ctx, req.(*UpdateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotebookAPI_DeleteNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).DeleteNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/DeleteNotebook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).DeleteNotebook(ctx, req.(*DeleteNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotebookAPI_GetNotebookTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotebookTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotebookAPIServer).GetNotebookTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NotebookAPI/GetNotebookTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotebookAPIServer).GetNotebookTree(ctx, req.(*GetNotebookTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotebookAPI_ServiceDesc is the grpc.ServiceDesc for NotebookAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotebookAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.notest.v1.NotebookAPI",
+	HandlerType: (*NotebookAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNotebook",
+			Handler:    _NotebookAPI_CreateNotebook_Handler,
+		},
+		{
+			MethodName: "GetNotebook",
+			Handler:    _NotebookAPI_GetNotebook_Handler,
+		},
+		{
+			MethodName: "ListNotebooks",
+			Handler:    _NotebookAPI_ListNotebooks_Handler,
+		},
+		{
+			MethodName: "UpdateNotebook",
+			Handler:    _NotebookAPI_UpdateNotebook_Handler,
+		},
+		{
+			MethodName: "DeleteNotebook",
+			Handler:    _NotebookAPI_DeleteNotebook_Handler,
+		},
+		{
+			MethodName: "GetNotebookTree",
+			Handler:    _NotebookAPI_GetNotebookTree_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/notes/v1/notebooks.proto",
+}
@@ -27,79 +27,399 @@ var file_api_notes_v1_notes_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70, 0x69, 0x2e,
 	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x6e,
 	0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf3, 0x03, 0x0a, 0x07, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x50, 0x49,
-	0x12, 0x4b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a,
-	0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x42, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x12, 0x49, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x52, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x4a, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0d,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x0f, 0x2e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x28, 0x01, 0x12, 0x36, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x08, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x0e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x28, 0x01, 0x30, 0x01, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79,
-	0x2d, 0x6b, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdd, 0x1d,
+	0x0a, 0x07, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x50, 0x49, 0x12, 0x5c, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x31,
+	0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b,
+	0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12,
+	0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x59, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x62, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x66, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x32, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x8a, 0xb5,
+	0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x71,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x13, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x7b,
+	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x60, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x11,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x7c, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f,
+	0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x14, 0x0a, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x65, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x3a, 0x6d, 0x6f, 0x76, 0x65, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x7e, 0x0a, 0x0b, 0x55,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x55, 0x6e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x2a, 0x2b, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x17, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x2f, 0x7b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x69, 0x64,
+	0x7d, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d, 0x12, 0x5c,
+	0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x01, 0x12, 0x98, 0x01, 0x0a,
+	0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x3a, 0x72, 0x65, 0x61, 0x64, 0x30, 0x01, 0x12, 0x7d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12,
+	0x08, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x66, 0x0a, 0x09, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61,
+	0x67, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x5e, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x11,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
+	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x3a, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x8a, 0xb5,
+	0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x86,
+	0x01, 0x0a, 0x11, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x64, 0x69, 0x66, 0x66, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x6d, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x10, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65,
+	0x61, 0x64, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x0f, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28, 0x01, 0x12, 0x6e, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x8a, 0xb5, 0x18,
+	0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x5f, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x74, 0x6f, 0x70, 0x8a, 0xb5,
+	0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x47,
+	0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x08, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x1a, 0x0e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68,
+	0x61, 0x74, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x68, 0x61, 0x74, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x8a, 0xb5, 0x18,
+	0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x42, 0x33, 0x5a,
+	0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67, 0x65,
+	0x6e, 0x69, 0x79, 0x2d, 0x6b, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2d, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_api_notes_v1_notes_proto_goTypes = []interface{}{
-	(*CreateNoteRequest)(nil),        // 0: CreateNoteRequest
-	(*GetNotesRequest)(nil),          // 1: GetNotesRequest
-	(*GetNoteRequest)(nil),           // 2: GetNoteRequest
-	(*DeleteNoteRequest)(nil),        // 3: DeleteNoteRequest
-	(*SubscribeToEventRequest)(nil),  // 4: SubscribeToEventRequest
-	(*MetricsRequest)(nil),           // 5: MetricsRequest
-	(*Message)(nil),                  // 6: Message
-	(*CreateNoteResponse)(nil),       // 7: CreateNoteResponse
-	(*GetNotesResponse)(nil),         // 8: GetNotesResponse
-	(*GetNoteResponse)(nil),          // 9: GetNoteResponse
-	(*DeleteNoteResponse)(nil),       // 10: DeleteNoteResponse
-	(*SubscribeToEventResponse)(nil), // 11: SubscribeToEventResponse
-	(*SummaryResponse)(nil),          // 12: SummaryResponse
-	(*ServerMessage)(nil),            // 13: ServerMessage
+	(*CreateNoteRequest)(nil),          // 0: CreateNoteRequest
+	(*GetNotesRequest)(nil),            // 1: GetNotesRequest
+	(*GetNoteRequest)(nil),             // 2: GetNoteRequest
+	(*SearchNotesRequest)(nil),         // 3: SearchNotesRequest
+	(*UpdateNoteRequest)(nil),          // 4: UpdateNoteRequest
+	(*DeleteNoteRequest)(nil),          // 5: DeleteNoteRequest
+	(*ListTrashRequest)(nil),           // 6: ListTrashRequest
+	(*RestoreNoteRequest)(nil),         // 7: RestoreNoteRequest
+	(*PurgeNoteRequest)(nil),           // 8: PurgeNoteRequest
+	(*AdminPurgeNoteRequest)(nil),      // 9: AdminPurgeNoteRequest
+	(*MoveNoteRequest)(nil),            // 10: MoveNoteRequest
+	(*ShareNoteRequest)(nil),           // 11: ShareNoteRequest
+	(*UnshareNoteRequest)(nil),         // 12: UnshareNoteRequest
+	(*ListCollaboratorsRequest)(nil),   // 13: ListCollaboratorsRequest
+	(*CreateShareLinkRequest)(nil),     // 14: CreateShareLinkRequest
+	(*RevokeShareLinkRequest)(nil),     // 15: RevokeShareLinkRequest
+	(*GetSharedNoteRequest)(nil),       // 16: GetSharedNoteRequest
+	(*UploadAttachmentRequest)(nil),    // 17: UploadAttachmentRequest
+	(*DownloadAttachmentRequest)(nil),  // 18: DownloadAttachmentRequest
+	(*ListAttachmentsRequest)(nil),     // 19: ListAttachmentsRequest
+	(*ListTagsRequest)(nil),            // 20: ListTagsRequest
+	(*RenameTagRequest)(nil),           // 21: RenameTagRequest
+	(*MergeTagsRequest)(nil),           // 22: MergeTagsRequest
+	(*ListNoteRevisionsRequest)(nil),   // 23: ListNoteRevisionsRequest
+	(*GetNoteRevisionRequest)(nil),     // 24: GetNoteRevisionRequest
+	(*DiffNoteRevisionsRequest)(nil),   // 25: DiffNoteRevisionsRequest
+	(*RevertNoteRequest)(nil),          // 26: RevertNoteRequest
+	(*SubscribeToEventRequest)(nil),    // 27: SubscribeToEventRequest
+	(*MetricsRequest)(nil),             // 28: MetricsRequest
+	(*GetNoteStatsRequest)(nil),        // 29: GetNoteStatsRequest
+	(*GetTopNotesRequest)(nil),         // 30: GetTopNotesRequest
+	(*Message)(nil),                    // 31: Message
+	(*EditSessionRequest)(nil),         // 32: EditSessionRequest
+	(*GetPresenceRequest)(nil),         // 33: GetPresenceRequest
+	(*ListChatMessagesRequest)(nil),    // 34: ListChatMessagesRequest
+	(*CreateNoteResponse)(nil),         // 35: CreateNoteResponse
+	(*GetNotesResponse)(nil),           // 36: GetNotesResponse
+	(*GetNoteResponse)(nil),            // 37: GetNoteResponse
+	(*SearchNotesResponse)(nil),        // 38: SearchNotesResponse
+	(*UpdateNoteResponse)(nil),         // 39: UpdateNoteResponse
+	(*DeleteNoteResponse)(nil),         // 40: DeleteNoteResponse
+	(*ListTrashResponse)(nil),          // 41: ListTrashResponse
+	(*RestoreNoteResponse)(nil),        // 42: RestoreNoteResponse
+	(*PurgeNoteResponse)(nil),          // 43: PurgeNoteResponse
+	(*AdminPurgeNoteResponse)(nil),     // 44: AdminPurgeNoteResponse
+	(*MoveNoteResponse)(nil),           // 45: MoveNoteResponse
+	(*ShareNoteResponse)(nil),          // 46: ShareNoteResponse
+	(*UnshareNoteResponse)(nil),        // 47: UnshareNoteResponse
+	(*ListCollaboratorsResponse)(nil),  // 48: ListCollaboratorsResponse
+	(*CreateShareLinkResponse)(nil),    // 49: CreateShareLinkResponse
+	(*RevokeShareLinkResponse)(nil),    // 50: RevokeShareLinkResponse
+	(*GetSharedNoteResponse)(nil),      // 51: GetSharedNoteResponse
+	(*UploadAttachmentResponse)(nil),   // 52: UploadAttachmentResponse
+	(*DownloadAttachmentResponse)(nil), // 53: DownloadAttachmentResponse
+	(*ListAttachmentsResponse)(nil),    // 54: ListAttachmentsResponse
+	(*ListTagsResponse)(nil),           // 55: ListTagsResponse
+	(*RenameTagResponse)(nil),          // 56: RenameTagResponse
+	(*MergeTagsResponse)(nil),          // 57: MergeTagsResponse
+	(*ListNoteRevisionsResponse)(nil),  // 58: ListNoteRevisionsResponse
+	(*GetNoteRevisionResponse)(nil),    // 59: GetNoteRevisionResponse
+	(*DiffNoteRevisionsResponse)(nil),  // 60: DiffNoteRevisionsResponse
+	(*RevertNoteResponse)(nil),         // 61: RevertNoteResponse
+	(*SubscribeToEventResponse)(nil),   // 62: SubscribeToEventResponse
+	(*SummaryResponse)(nil),            // 63: SummaryResponse
+	(*GetNoteStatsResponse)(nil),       // 64: GetNoteStatsResponse
+	(*GetTopNotesResponse)(nil),        // 65: GetTopNotesResponse
+	(*ServerMessage)(nil),              // 66: ServerMessage
+	(*EditSessionResponse)(nil),        // 67: EditSessionResponse
+	(*GetPresenceResponse)(nil),        // 68: GetPresenceResponse
+	(*ListChatMessagesResponse)(nil),   // 69: ListChatMessagesResponse
 }
 var file_api_notes_v1_notes_proto_depIdxs = []int32{
 	0,  // 0: api.notest.v1.NoteAPI.CreateNote:input_type -> CreateNoteRequest
 	1,  // 1: api.notest.v1.NoteAPI.GetNotes:input_type -> GetNotesRequest
 	2,  // 2: api.notest.v1.NoteAPI.GetNote:input_type -> GetNoteRequest
-	3,  // 3: api.notest.v1.NoteAPI.DeleteNote:input_type -> DeleteNoteRequest
-	4,  // 4: api.notest.v1.NoteAPI.SubscribeToEvents:input_type -> SubscribeToEventRequest
-	5,  // 5: api.notest.v1.NoteAPI.UploadMetrics:input_type -> MetricsRequest
-	6,  // 6: api.notest.v1.NoteAPI.Chat:input_type -> Message
-	7,  // 7: api.notest.v1.NoteAPI.CreateNote:output_type -> CreateNoteResponse
-	8,  // 8: api.notest.v1.NoteAPI.GetNotes:output_type -> GetNotesResponse
-	9,  // 9: api.notest.v1.NoteAPI.GetNote:output_type -> GetNoteResponse
-	10, // 10: api.notest.v1.NoteAPI.DeleteNote:output_type -> DeleteNoteResponse
-	11, // 11: api.notest.v1.NoteAPI.SubscribeToEvents:output_type -> SubscribeToEventResponse
-	12, // 12: api.notest.v1.NoteAPI.UploadMetrics:output_type -> SummaryResponse
-	13, // 13: api.notest.v1.NoteAPI.Chat:output_type -> ServerMessage
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	3,  // 3: api.notest.v1.NoteAPI.SearchNotes:input_type -> SearchNotesRequest
+	4,  // 4: api.notest.v1.NoteAPI.UpdateNote:input_type -> UpdateNoteRequest
+	5,  // 5: api.notest.v1.NoteAPI.DeleteNote:input_type -> DeleteNoteRequest
+	6,  // 6: api.notest.v1.NoteAPI.ListTrash:input_type -> ListTrashRequest
+	7,  // 7: api.notest.v1.NoteAPI.RestoreNote:input_type -> RestoreNoteRequest
+	8,  // 8: api.notest.v1.NoteAPI.PurgeNote:input_type -> PurgeNoteRequest
+	9,  // 9: api.notest.v1.NoteAPI.AdminPurgeNote:input_type -> AdminPurgeNoteRequest
+	10, // 10: api.notest.v1.NoteAPI.MoveNote:input_type -> MoveNoteRequest
+	11, // 11: api.notest.v1.NoteAPI.ShareNote:input_type -> ShareNoteRequest
+	12, // 12: api.notest.v1.NoteAPI.UnshareNote:input_type -> UnshareNoteRequest
+	13, // 13: api.notest.v1.NoteAPI.ListCollaborators:input_type -> ListCollaboratorsRequest
+	14, // 14: api.notest.v1.NoteAPI.CreateShareLink:input_type -> CreateShareLinkRequest
+	15, // 15: api.notest.v1.NoteAPI.RevokeShareLink:input_type -> RevokeShareLinkRequest
+	16, // 16: api.notest.v1.NoteAPI.GetSharedNote:input_type -> GetSharedNoteRequest
+	17, // 17: api.notest.v1.NoteAPI.UploadAttachment:input_type -> UploadAttachmentRequest
+	18, // 18: api.notest.v1.NoteAPI.DownloadAttachment:input_type -> DownloadAttachmentRequest
+	19, // 19: api.notest.v1.NoteAPI.ListAttachments:input_type -> ListAttachmentsRequest
+	20, // 20: api.notest.v1.NoteAPI.ListTags:input_type -> ListTagsRequest
+	21, // 21: api.notest.v1.NoteAPI.RenameTag:input_type -> RenameTagRequest
+	22, // 22: api.notest.v1.NoteAPI.MergeTags:input_type -> MergeTagsRequest
+	23, // 23: api.notest.v1.NoteAPI.ListNoteRevisions:input_type -> ListNoteRevisionsRequest
+	24, // 24: api.notest.v1.NoteAPI.GetNoteRevision:input_type -> GetNoteRevisionRequest
+	25, // 25: api.notest.v1.NoteAPI.DiffNoteRevisions:input_type -> DiffNoteRevisionsRequest
+	26, // 26: api.notest.v1.NoteAPI.RevertNote:input_type -> RevertNoteRequest
+	27, // 27: api.notest.v1.NoteAPI.SubscribeToEvents:input_type -> SubscribeToEventRequest
+	28, // 28: api.notest.v1.NoteAPI.UploadMetrics:input_type -> MetricsRequest
+	29, // 29: api.notest.v1.NoteAPI.GetNoteStats:input_type -> GetNoteStatsRequest
+	30, // 30: api.notest.v1.NoteAPI.GetTopNotes:input_type -> GetTopNotesRequest
+	31, // 31: api.notest.v1.NoteAPI.Chat:input_type -> Message
+	32, // 32: api.notest.v1.NoteAPI.EditSession:input_type -> EditSessionRequest
+	33, // 33: api.notest.v1.NoteAPI.GetPresence:input_type -> GetPresenceRequest
+	34, // 34: api.notest.v1.NoteAPI.ListChatMessages:input_type -> ListChatMessagesRequest
+	35, // 35: api.notest.v1.NoteAPI.CreateNote:output_type -> CreateNoteResponse
+	36, // 36: api.notest.v1.NoteAPI.GetNotes:output_type -> GetNotesResponse
+	37, // 37: api.notest.v1.NoteAPI.GetNote:output_type -> GetNoteResponse
+	38, // 38: api.notest.v1.NoteAPI.SearchNotes:output_type -> SearchNotesResponse
+	39, // 39: api.notest.v1.NoteAPI.UpdateNote:output_type -> UpdateNoteResponse
+	40, // 40: api.notest.v1.NoteAPI.DeleteNote:output_type -> DeleteNoteResponse
+	41, // 41: api.notest.v1.NoteAPI.ListTrash:output_type -> ListTrashResponse
+	42, // 42: api.notest.v1.NoteAPI.RestoreNote:output_type -> RestoreNoteResponse
+	43, // 43: api.notest.v1.NoteAPI.PurgeNote:output_type -> PurgeNoteResponse
+	44, // 44: api.notest.v1.NoteAPI.AdminPurgeNote:output_type -> AdminPurgeNoteResponse
+	45, // 45: api.notest.v1.NoteAPI.MoveNote:output_type -> MoveNoteResponse
+	46, // 46: api.notest.v1.NoteAPI.ShareNote:output_type -> ShareNoteResponse
+	47, // 47: api.notest.v1.NoteAPI.UnshareNote:output_type -> UnshareNoteResponse
+	48, // 48: api.notest.v1.NoteAPI.ListCollaborators:output_type -> ListCollaboratorsResponse
+	49, // 49: api.notest.v1.NoteAPI.CreateShareLink:output_type -> CreateShareLinkResponse
+	50, // 50: api.notest.v1.NoteAPI.RevokeShareLink:output_type -> RevokeShareLinkResponse
+	51, // 51: api.notest.v1.NoteAPI.GetSharedNote:output_type -> GetSharedNoteResponse
+	52, // 52: api.notest.v1.NoteAPI.UploadAttachment:output_type -> UploadAttachmentResponse
+	53, // 53: api.notest.v1.NoteAPI.DownloadAttachment:output_type -> DownloadAttachmentResponse
+	54, // 54: api.notest.v1.NoteAPI.ListAttachments:output_type -> ListAttachmentsResponse
+	55, // 55: api.notest.v1.NoteAPI.ListTags:output_type -> ListTagsResponse
+	56, // 56: api.notest.v1.NoteAPI.RenameTag:output_type -> RenameTagResponse
+	57, // 57: api.notest.v1.NoteAPI.MergeTags:output_type -> MergeTagsResponse
+	58, // 58: api.notest.v1.NoteAPI.ListNoteRevisions:output_type -> ListNoteRevisionsResponse
+	59, // 59: api.notest.v1.NoteAPI.GetNoteRevision:output_type -> GetNoteRevisionResponse
+	60, // 60: api.notest.v1.NoteAPI.DiffNoteRevisions:output_type -> DiffNoteRevisionsResponse
+	61, // 61: api.notest.v1.NoteAPI.RevertNote:output_type -> RevertNoteResponse
+	62, // 62: api.notest.v1.NoteAPI.SubscribeToEvents:output_type -> SubscribeToEventResponse
+	63, // 63: api.notest.v1.NoteAPI.UploadMetrics:output_type -> SummaryResponse
+	64, // 64: api.notest.v1.NoteAPI.GetNoteStats:output_type -> GetNoteStatsResponse
+	65, // 65: api.notest.v1.NoteAPI.GetTopNotes:output_type -> GetTopNotesResponse
+	66, // 66: api.notest.v1.NoteAPI.Chat:output_type -> ServerMessage
+	67, // 67: api.notest.v1.NoteAPI.EditSession:output_type -> EditSessionResponse
+	68, // 68: api.notest.v1.NoteAPI.GetPresence:output_type -> GetPresenceResponse
+	69, // 69: api.notest.v1.NoteAPI.ListChatMessages:output_type -> ListChatMessagesResponse
+	35, // [35:70] is the sub-list for method output_type
+	0,  // [0:35] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -111,6 +431,7 @@ func file_api_notes_v1_notes_proto_init() {
 		return
 	}
 	file_api_notes_v1_messages_proto_init()
+	file_api_notes_v1_auth_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -25,10 +25,49 @@ type NoteAPIClient interface {
 	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error)
 	GetNotes(ctx context.Context, in *GetNotesRequest, opts ...grpc.CallOption) (*GetNotesResponse, error)
 	GetNote(ctx context.Context, in *GetNoteRequest, opts ...grpc.CallOption) (*GetNoteResponse, error)
+	SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error)
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
 	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error)
+	PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error)
+	// AdminPurgeNote purges a trashed note of any user.
+	AdminPurgeNote(ctx context.Context, in *AdminPurgeNoteRequest, opts ...grpc.CallOption) (*AdminPurgeNoteResponse, error)
+	MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error)
+	ShareNote(ctx context.Context, in *ShareNoteRequest, opts ...grpc.CallOption) (*ShareNoteResponse, error)
+	UnshareNote(ctx context.Context, in *UnshareNoteRequest, opts ...grpc.CallOption) (*UnshareNoteResponse, error)
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	// GetSharedNote is public, it does not require an authorization token.
+	GetSharedNote(ctx context.Context, in *GetSharedNoteRequest, opts ...grpc.CallOption) (*GetSharedNoteResponse, error)
+	// HTTP clients upload a multipart/form-data "file" part
+	// to POST /v1/notes/{note_id}/attachments.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_UploadAttachmentClient, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (NoteAPI_DownloadAttachmentClient, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error)
+	GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error)
+	DiffNoteRevisions(ctx context.Context, in *DiffNoteRevisionsRequest, opts ...grpc.CallOption) (*DiffNoteRevisionsResponse, error)
+	RevertNote(ctx context.Context, in *RevertNoteRequest, opts ...grpc.CallOption) (*RevertNoteResponse, error)
 	SubscribeToEvents(ctx context.Context, in *SubscribeToEventRequest, opts ...grpc.CallOption) (NoteAPI_SubscribeToEventsClient, error)
+	// Client streams note views, they are aggregated into hourly counters.
 	UploadMetrics(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_UploadMetricsClient, error)
+	GetNoteStats(ctx context.Context, in *GetNoteStatsRequest, opts ...grpc.CallOption) (*GetNoteStatsResponse, error)
+	GetTopNotes(ctx context.Context, in *GetTopNotesRequest, opts ...grpc.CallOption) (*GetTopNotesResponse, error)
+	// Discussion of a note: the first client message selects the note room,
+	// messages of every participant are broadcast to the whole room.
 	Chat(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_ChatClient, error)
+	// Collaborative editing of the note content: the first request joins
+	// the session, operations of participants are transformed against each
+	// other and the merged content is saved to the note periodically.
+	EditSession(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_EditSessionClient, error)
+	// Users having the note open in SubscribeToEvents, Chat or EditSession streams.
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+	ListChatMessages(ctx context.Context, in *ListChatMessagesRequest, opts ...grpc.CallOption) (*ListChatMessagesResponse, error)
 }
 
 type noteAPIClient struct {
@@ -66,6 +105,24 @@ func (c *noteAPIClient) GetNote(ctx context.Context, in *GetNoteRequest, opts ..
 	return out, nil
 }
 
+func (c *noteAPIClient) SearchNotes(ctx context.Context, in *SearchNotesRequest, opts ...grpc.CallOption) (*SearchNotesResponse, error) {
+	out := new(SearchNotesResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/SearchNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error) {
+	out := new(UpdateNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/UpdateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteAPIClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
 	out := new(DeleteNoteResponse)
 	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/DeleteNote", in, out, opts...)
@@ -75,8 +132,245 @@ func (c *noteAPIClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, o
 	return out, nil
 }
 
+func (c *noteAPIClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) RestoreNote(ctx context.Context, in *RestoreNoteRequest, opts ...grpc.CallOption) (*RestoreNoteResponse, error) {
+	out := new(RestoreNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/RestoreNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) PurgeNote(ctx context.Context, in *PurgeNoteRequest, opts ...grpc.CallOption) (*PurgeNoteResponse, error) {
+	out := new(PurgeNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/PurgeNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) AdminPurgeNote(ctx context.Context, in *AdminPurgeNoteRequest, opts ...grpc.CallOption) (*AdminPurgeNoteResponse, error) {
+	out := new(AdminPurgeNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/AdminPurgeNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) MoveNote(ctx context.Context, in *MoveNoteRequest, opts ...grpc.CallOption) (*MoveNoteResponse, error) {
+	out := new(MoveNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/MoveNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) ShareNote(ctx context.Context, in *ShareNoteRequest, opts ...grpc.CallOption) (*ShareNoteResponse, error) {
+	out := new(ShareNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ShareNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) UnshareNote(ctx context.Context, in *UnshareNoteRequest, opts ...grpc.CallOption) (*UnshareNoteResponse, error) {
+	out := new(UnshareNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/UnshareNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/CreateShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/RevokeShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) GetSharedNote(ctx context.Context, in *GetSharedNoteRequest, opts ...grpc.CallOption) (*GetSharedNoteResponse, error) {
+	out := new(GetSharedNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/GetSharedNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_UploadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[0], "/api.notest.v1.NoteAPI/UploadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &noteAPIUploadAttachmentClient{stream}
+	return x, nil
+}
+
+type NoteAPI_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type noteAPIUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *noteAPIUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *noteAPIUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *noteAPIClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (NoteAPI_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[1], "/api.notest.v1.NoteAPI/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &noteAPIDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NoteAPI_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type noteAPIDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *noteAPIDownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *noteAPIClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) ListNoteRevisions(ctx context.Context, in *ListNoteRevisionsRequest, opts ...grpc.CallOption) (*ListNoteRevisionsResponse, error) {
+	out := new(ListNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListNoteRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) GetNoteRevision(ctx context.Context, in *GetNoteRevisionRequest, opts ...grpc.CallOption) (*GetNoteRevisionResponse, error) {
+	out := new(GetNoteRevisionResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/GetNoteRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) DiffNoteRevisions(ctx context.Context, in *DiffNoteRevisionsRequest, opts ...grpc.CallOption) (*DiffNoteRevisionsResponse, error) {
+	out := new(DiffNoteRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/DiffNoteRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) RevertNote(ctx context.Context, in *RevertNoteRequest, opts ...grpc.CallOption) (*RevertNoteResponse, error) {
+	out := new(RevertNoteResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/RevertNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteAPIClient) SubscribeToEvents(ctx context.Context, in *SubscribeToEventRequest, opts ...grpc.CallOption) (NoteAPI_SubscribeToEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[0], "/api.notest.v1.NoteAPI/SubscribeToEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[2], "/api.notest.v1.NoteAPI/SubscribeToEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +402,7 @@ func (x *noteAPISubscribeToEventsClient) Recv() (*SubscribeToEventResponse, erro
 }
 
 func (c *noteAPIClient) UploadMetrics(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_UploadMetricsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[1], "/api.notest.v1.NoteAPI/UploadMetrics", opts...)
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[3], "/api.notest.v1.NoteAPI/UploadMetrics", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -141,8 +435,26 @@ func (x *noteAPIUploadMetricsClient) CloseAndRecv() (*SummaryResponse, error) {
 	return m, nil
 }
 
+func (c *noteAPIClient) GetNoteStats(ctx context.Context, in *GetNoteStatsRequest, opts ...grpc.CallOption) (*GetNoteStatsResponse, error) {
+	out := new(GetNoteStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/GetNoteStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) GetTopNotes(ctx context.Context, in *GetTopNotesRequest, opts ...grpc.CallOption) (*GetTopNotesResponse, error) {
+	out := new(GetTopNotesResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/GetTopNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *noteAPIClient) Chat(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_ChatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[2], "/api.notest.v1.NoteAPI/Chat", opts...)
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[4], "/api.notest.v1.NoteAPI/Chat", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +484,55 @@ func (x *noteAPIChatClient) Recv() (*ServerMessage, error) {
 	return m, nil
 }
 
+func (c *noteAPIClient) EditSession(ctx context.Context, opts ...grpc.CallOption) (NoteAPI_EditSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NoteAPI_ServiceDesc.Streams[5], "/api.notest.v1.NoteAPI/EditSession", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &noteAPIEditSessionClient{stream}
+	return x, nil
+}
+
+type NoteAPI_EditSessionClient interface {
+	Send(*EditSessionRequest) error
+	Recv() (*EditSessionResponse, error)
+	grpc.ClientStream
+}
+
+type noteAPIEditSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *noteAPIEditSessionClient) Send(m *EditSessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *noteAPIEditSessionClient) Recv() (*EditSessionResponse, error) {
+	m := new(EditSessionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *noteAPIClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/GetPresence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *noteAPIClient) ListChatMessages(ctx context.Context, in *ListChatMessagesRequest, opts ...grpc.CallOption) (*ListChatMessagesResponse, error) {
+	out := new(ListChatMessagesResponse)
+	err := c.cc.Invoke(ctx, "/api.notest.v1.NoteAPI/ListChatMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NoteAPIServer is the server API for NoteAPI service.
 // All implementations should embed UnimplementedNoteAPIServer
 // for forward compatibility
@@ -179,10 +540,49 @@ type NoteAPIServer interface {
 	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
 	GetNotes(context.Context, *GetNotesRequest) (*GetNotesResponse, error)
 	GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error)
+	SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
 	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error)
+	PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error)
+	// AdminPurgeNote purges a trashed note of any user.
+	AdminPurgeNote(context.Context, *AdminPurgeNoteRequest) (*AdminPurgeNoteResponse, error)
+	MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error)
+	ShareNote(context.Context, *ShareNoteRequest) (*ShareNoteResponse, error)
+	UnshareNote(context.Context, *UnshareNoteRequest) (*UnshareNoteResponse, error)
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	// GetSharedNote is public, it does not require an authorization token.
+	GetSharedNote(context.Context, *GetSharedNoteRequest) (*GetSharedNoteResponse, error)
+	// HTTP clients upload a multipart/form-data "file" part
+	// to POST /v1/notes/{note_id}/attachments.
+	UploadAttachment(NoteAPI_UploadAttachmentServer) error
+	DownloadAttachment(*DownloadAttachmentRequest, NoteAPI_DownloadAttachmentServer) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error)
+	GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error)
+	DiffNoteRevisions(context.Context, *DiffNoteRevisionsRequest) (*DiffNoteRevisionsResponse, error)
+	RevertNote(context.Context, *RevertNoteRequest) (*RevertNoteResponse, error)
 	SubscribeToEvents(*SubscribeToEventRequest, NoteAPI_SubscribeToEventsServer) error
+	// Client streams note views, they are aggregated into hourly counters.
 	UploadMetrics(NoteAPI_UploadMetricsServer) error
+	GetNoteStats(context.Context, *GetNoteStatsRequest) (*GetNoteStatsResponse, error)
+	GetTopNotes(context.Context, *GetTopNotesRequest) (*GetTopNotesResponse, error)
+	// Discussion of a note: the first client message selects the note room,
+	// messages of every participant are broadcast to the whole room.
 	Chat(NoteAPI_ChatServer) error
+	// Collaborative editing of the note content: the first request joins
+	// the session, operations of participants are transformed against each
+	// other and the merged content is saved to the note periodically.
+	EditSession(NoteAPI_EditSessionServer) error
+	// Users having the note open in SubscribeToEvents, Chat or EditSession streams.
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
+	ListChatMessages(context.Context, *ListChatMessagesRequest) (*ListChatMessagesResponse, error)
 }
 
 // UnimplementedNoteAPIServer should be embedded to have forward compatible implementations.
@@ -198,18 +598,102 @@ func (UnimplementedNoteAPIServer) GetNotes(context.Context, *GetNotesRequest) (*
 func (UnimplementedNoteAPIServer) GetNote(context.Context, *GetNoteRequest) (*GetNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNote not implemented")
 }
+func (UnimplementedNoteAPIServer) SearchNotes(context.Context, *SearchNotesRequest) (*SearchNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNotes not implemented")
+}
+func (UnimplementedNoteAPIServer) UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+}
 func (UnimplementedNoteAPIServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
 }
+func (UnimplementedNoteAPIServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedNoteAPIServer) RestoreNote(context.Context, *RestoreNoteRequest) (*RestoreNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNote not implemented")
+}
+func (UnimplementedNoteAPIServer) PurgeNote(context.Context, *PurgeNoteRequest) (*PurgeNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeNote not implemented")
+}
+func (UnimplementedNoteAPIServer) AdminPurgeNote(context.Context, *AdminPurgeNoteRequest) (*AdminPurgeNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPurgeNote not implemented")
+}
+func (UnimplementedNoteAPIServer) MoveNote(context.Context, *MoveNoteRequest) (*MoveNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveNote not implemented")
+}
+func (UnimplementedNoteAPIServer) ShareNote(context.Context, *ShareNoteRequest) (*ShareNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareNote not implemented")
+}
+func (UnimplementedNoteAPIServer) UnshareNote(context.Context, *UnshareNoteRequest) (*UnshareNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareNote not implemented")
+}
+func (UnimplementedNoteAPIServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedNoteAPIServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedNoteAPIServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedNoteAPIServer) GetSharedNote(context.Context, *GetSharedNoteRequest) (*GetSharedNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedNote not implemented")
+}
+func (UnimplementedNoteAPIServer) UploadAttachment(NoteAPI_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedNoteAPIServer) DownloadAttachment(*DownloadAttachmentRequest, NoteAPI_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedNoteAPIServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedNoteAPIServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedNoteAPIServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedNoteAPIServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedNoteAPIServer) ListNoteRevisions(context.Context, *ListNoteRevisionsRequest) (*ListNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteRevisions not implemented")
+}
+func (UnimplementedNoteAPIServer) GetNoteRevision(context.Context, *GetNoteRevisionRequest) (*GetNoteRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteRevision not implemented")
+}
+func (UnimplementedNoteAPIServer) DiffNoteRevisions(context.Context, *DiffNoteRevisionsRequest) (*DiffNoteRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffNoteRevisions not implemented")
+}
+func (UnimplementedNoteAPIServer) RevertNote(context.Context, *RevertNoteRequest) (*RevertNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertNote not implemented")
+}
 func (UnimplementedNoteAPIServer) SubscribeToEvents(*SubscribeToEventRequest, NoteAPI_SubscribeToEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToEvents not implemented")
 }
 func (UnimplementedNoteAPIServer) UploadMetrics(NoteAPI_UploadMetricsServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadMetrics not implemented")
 }
+func (UnimplementedNoteAPIServer) GetNoteStats(context.Context, *GetNoteStatsRequest) (*GetNoteStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNoteStats not implemented")
+}
+func (UnimplementedNoteAPIServer) GetTopNotes(context.Context, *GetTopNotesRequest) (*GetTopNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopNotes not implemented")
+}
 func (UnimplementedNoteAPIServer) Chat(NoteAPI_ChatServer) error {
 	return status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
+func (UnimplementedNoteAPIServer) EditSession(NoteAPI_EditSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method EditSession not implemented")
+}
+func (UnimplementedNoteAPIServer) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedNoteAPIServer) ListChatMessages(context.Context, *ListChatMessagesRequest) (*ListChatMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChatMessages not implemented")
+}
 
 // UnsafeNoteAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to NoteAPIServer will
@@ -276,28 +760,453 @@ func _NoteAPI_GetNote_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NoteAPI_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNoteRequest)
+func _NoteAPI_SearchNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NoteAPIServer).DeleteNote(ctx, in)
+		return srv.(NoteAPIServer).SearchNotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.notest.v1.NoteAPI/DeleteNote",
+		FullMethod: "/api.notest.v1.NoteAPI/SearchNotes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NoteAPIServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+		return srv.(NoteAPIServer).SearchNotes(ctx, req.(*SearchNotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NoteAPI_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeToEventRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _NoteAPI_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/UpdateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/DeleteNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_RestoreNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).RestoreNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/RestoreNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).RestoreNote(ctx, req.(*RestoreNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_PurgeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).PurgeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/PurgeNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).PurgeNote(ctx, req.(*PurgeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_AdminPurgeNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPurgeNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).AdminPurgeNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/AdminPurgeNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).AdminPurgeNote(ctx, req.(*AdminPurgeNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_MoveNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).MoveNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/MoveNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).MoveNote(ctx, req.(*MoveNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ShareNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ShareNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ShareNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ShareNote(ctx, req.(*ShareNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_UnshareNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).UnshareNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/UnshareNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).UnshareNote(ctx, req.(*UnshareNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/CreateShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/RevokeShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_GetSharedNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).GetSharedNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/GetSharedNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).GetSharedNote(ctx, req.(*GetSharedNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NoteAPIServer).UploadAttachment(&noteAPIUploadAttachmentServer{stream})
+}
+
+type NoteAPI_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type noteAPIUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *noteAPIUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *noteAPIUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NoteAPI_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NoteAPIServer).DownloadAttachment(m, &noteAPIDownloadAttachmentServer{stream})
+}
+
+type NoteAPI_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type noteAPIDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *noteAPIDownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NoteAPI_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/RenameTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/MergeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ListNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListNoteRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListNoteRevisions(ctx, req.(*ListNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_GetNoteRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).GetNoteRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/GetNoteRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).GetNoteRevision(ctx, req.(*GetNoteRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_DiffNoteRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffNoteRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).DiffNoteRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/DiffNoteRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).DiffNoteRevisions(ctx, req.(*DiffNoteRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_RevertNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).RevertNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/RevertNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).RevertNote(ctx, req.(*RevertNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_SubscribeToEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeToEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
 	return srv.(NoteAPIServer).SubscribeToEvents(m, &noteAPISubscribeToEventsServer{stream})
 }
@@ -341,6 +1250,42 @@ func (x *noteAPIUploadMetricsServer) Recv() (*MetricsRequest, error) {
 	return m, nil
 }
 
+func _NoteAPI_GetNoteStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNoteStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).GetNoteStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/GetNoteStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).GetNoteStats(ctx, req.(*GetNoteStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_GetTopNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).GetTopNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/GetTopNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).GetTopNotes(ctx, req.(*GetTopNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NoteAPI_Chat_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(NoteAPIServer).Chat(&noteAPIChatServer{stream})
 }
@@ -367,6 +1312,68 @@ func (x *noteAPIChatServer) Recv() (*Message, error) {
 	return m, nil
 }
 
+func _NoteAPI_EditSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NoteAPIServer).EditSession(&noteAPIEditSessionServer{stream})
+}
+
+type NoteAPI_EditSessionServer interface {
+	Send(*EditSessionResponse) error
+	Recv() (*EditSessionRequest, error)
+	grpc.ServerStream
+}
+
+type noteAPIEditSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *noteAPIEditSessionServer) Send(m *EditSessionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *noteAPIEditSessionServer) Recv() (*EditSessionRequest, error) {
+	m := new(EditSessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NoteAPI_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/GetPresence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NoteAPI_ListChatMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NoteAPIServer).ListChatMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.notest.v1.NoteAPI/ListChatMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NoteAPIServer).ListChatMessages(ctx, req.(*ListChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NoteAPI_ServiceDesc is the grpc.ServiceDesc for NoteAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -386,12 +1393,122 @@ var NoteAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNote",
 			Handler:    _NoteAPI_GetNote_Handler,
 		},
+		{
+			MethodName: "SearchNotes",
+			Handler:    _NoteAPI_SearchNotes_Handler,
+		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _NoteAPI_UpdateNote_Handler,
+		},
 		{
 			MethodName: "DeleteNote",
 			Handler:    _NoteAPI_DeleteNote_Handler,
 		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _NoteAPI_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreNote",
+			Handler:    _NoteAPI_RestoreNote_Handler,
+		},
+		{
+			MethodName: "PurgeNote",
+			Handler:    _NoteAPI_PurgeNote_Handler,
+		},
+		{
+			MethodName: "AdminPurgeNote",
+			Handler:    _NoteAPI_AdminPurgeNote_Handler,
+		},
+		{
+			MethodName: "MoveNote",
+			Handler:    _NoteAPI_MoveNote_Handler,
+		},
+		{
+			MethodName: "ShareNote",
+			Handler:    _NoteAPI_ShareNote_Handler,
+		},
+		{
+			MethodName: "UnshareNote",
+			Handler:    _NoteAPI_UnshareNote_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _NoteAPI_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _NoteAPI_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _NoteAPI_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedNote",
+			Handler:    _NoteAPI_GetSharedNote_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _NoteAPI_ListAttachments_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _NoteAPI_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _NoteAPI_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _NoteAPI_MergeTags_Handler,
+		},
+		{
+			MethodName: "ListNoteRevisions",
+			Handler:    _NoteAPI_ListNoteRevisions_Handler,
+		},
+		{
+			MethodName: "GetNoteRevision",
+			Handler:    _NoteAPI_GetNoteRevision_Handler,
+		},
+		{
+			MethodName: "DiffNoteRevisions",
+			Handler:    _NoteAPI_DiffNoteRevisions_Handler,
+		},
+		{
+			MethodName: "RevertNote",
+			Handler:    _NoteAPI_RevertNote_Handler,
+		},
+		{
+			MethodName: "GetNoteStats",
+			Handler:    _NoteAPI_GetNoteStats_Handler,
+		},
+		{
+			MethodName: "GetTopNotes",
+			Handler:    _NoteAPI_GetTopNotes_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _NoteAPI_GetPresence_Handler,
+		},
+		{
+			MethodName: "ListChatMessages",
+			Handler:    _NoteAPI_ListChatMessages_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _NoteAPI_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _NoteAPI_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeToEvents",
 			Handler:       _NoteAPI_SubscribeToEvents_Handler,
@@ -408,6 +1525,12 @@ var NoteAPI_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "EditSession",
+			Handler:       _NoteAPI_EditSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "api/notes/v1/notes.proto",
 }
@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: api/notes/v1/webhooks.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_api_notes_v1_webhooks_proto protoreflect.FileDescriptor
+
+var file_api_notes_v1_webhooks_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61,
+	0x70, 0x69, 0x2e, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x61, 0x70,
+	0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x32, 0xfb, 0x07, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41, 0x50, 0x49, 0x12,
+	0x68, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x12, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x8a, 0xb5, 0x18, 0x0c,
+	0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0x72, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x15, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x8a,
+	0xb5, 0x18, 0x0d, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x94, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
+	0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x8a, 0xb5, 0x18, 0x0c, 0x12, 0x0a, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0xa1, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d,
+	0x3a, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x8a, 0xb5, 0x18, 0x0d, 0x12, 0x0b,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0xb0, 0x01, 0x0a, 0x1a,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f,
+	0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x8a, 0xb5, 0x18, 0x13, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x72, 0x65, 0x61, 0x64, 0x12, 0xbd,
+	0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a,
+	0x01, 0x2a, 0x22, 0x42, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x8a, 0xb5, 0x18, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x33,
+	0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x76, 0x67,
+	0x65, 0x6e, 0x69, 0x79, 0x2d, 0x6b, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x6b, 0x6f, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2d, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x2f, 0x70, 0x67, 0x6b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_api_notes_v1_webhooks_proto_goTypes = []interface{}{
+	(*CreateWebhookRequest)(nil),               // 0: CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),                // 1: ListWebhooksRequest
+	(*DeleteWebhookRequest)(nil),               // 2: DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),       // 3: ListWebhookDeliveriesRequest
+	(*RedeliverWebhookRequest)(nil),            // 4: RedeliverWebhookRequest
+	(*AdminListWebhookDeliveriesRequest)(nil),  // 5: AdminListWebhookDeliveriesRequest
+	(*AdminRedeliverWebhookRequest)(nil),       // 6: AdminRedeliverWebhookRequest
+	(*CreateWebhookResponse)(nil),              // 7: CreateWebhookResponse
+	(*ListWebhooksResponse)(nil),               // 8: ListWebhooksResponse
+	(*DeleteWebhookResponse)(nil),              // 9: DeleteWebhookResponse
+	(*ListWebhookDeliveriesResponse)(nil),      // 10: ListWebhookDeliveriesResponse
+	(*RedeliverWebhookResponse)(nil),           // 11: RedeliverWebhookResponse
+	(*AdminListWebhookDeliveriesResponse)(nil), // 12: AdminListWebhookDeliveriesResponse
+	(*AdminRedeliverWebhookResponse)(nil),      // 13: AdminRedeliverWebhookResponse
+}
+var file_api_notes_v1_webhooks_proto_depIdxs = []int32{
+	0,  // 0: api.notest.v1.WebhookAPI.CreateWebhook:input_type -> CreateWebhookRequest
+	1,  // 1: api.notest.v1.WebhookAPI.ListWebhooks:input_type -> ListWebhooksRequest
+	2,  // 2: api.notest.v1.WebhookAPI.DeleteWebhook:input_type -> DeleteWebhookRequest
+	3,  // 3: api.notest.v1.WebhookAPI.ListWebhookDeliveries:input_type -> ListWebhookDeliveriesRequest
+	4,  // 4: api.notest.v1.WebhookAPI.RedeliverWebhook:input_type -> RedeliverWebhookRequest
+	5,  // 5: api.notest.v1.WebhookAPI.AdminListWebhookDeliveries:input_type -> AdminListWebhookDeliveriesRequest
+	6,  // 6: api.notest.v1.WebhookAPI.AdminRedeliverWebhook:input_type -> AdminRedeliverWebhookRequest
+	7,  // 7: api.notest.v1.WebhookAPI.CreateWebhook:output_type -> CreateWebhookResponse
+	8,  // 8: api.notest.v1.WebhookAPI.ListWebhooks:output_type -> ListWebhooksResponse
+	9,  // 9: api.notest.v1.WebhookAPI.DeleteWebhook:output_type -> DeleteWebhookResponse
+	10, // 10: api.notest.v1.WebhookAPI.ListWebhookDeliveries:output_type -> ListWebhookDeliveriesResponse
+	11, // 11: api.notest.v1.WebhookAPI.RedeliverWebhook:output_type -> RedeliverWebhookResponse
+	12, // 12: api.notest.v1.WebhookAPI.AdminListWebhookDeliveries:output_type -> AdminListWebhookDeliveriesResponse
+	13, // 13: api.notest.v1.WebhookAPI.AdminRedeliverWebhook:output_type -> AdminRedeliverWebhookResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_notes_v1_webhooks_proto_init() }
+func file_api_notes_v1_webhooks_proto_init() {
+	if File_api_notes_v1_webhooks_proto != nil {
+		return
+	}
+	file_api_notes_v1_messages_proto_init()
+	file_api_notes_v1_auth_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_notes_v1_webhooks_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_notes_v1_webhooks_proto_goTypes,
+		DependencyIndexes: file_api_notes_v1_webhooks_proto_depIdxs,
+	}.Build()
+	File_api_notes_v1_webhooks_proto = out.File
+	file_api_notes_v1_webhooks_proto_rawDesc = nil
+	file_api_notes_v1_webhooks_proto_goTypes = nil
+	file_api_notes_v1_webhooks_proto_depIdxs = nil
+}
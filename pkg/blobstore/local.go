@@ -0,0 +1,117 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+//go:generate options-gen -out-filename=local_options.gen.go -from-struct=Options
+type Options struct {
+	dir string `option:"mandatory" validate:"required"`
+}
+
+// Local keeps blobs as files under a directory, every blob is written
+// to a temporary file first so readers never see partial content.
+type Local struct {
+	Options
+}
+
+func NewLocal(opts Options) (*Local, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate local blobstore options: %v", err)
+	}
+
+	if err := os.MkdirAll(opts.dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create blobstore dir: %v", err)
+	}
+
+	return &Local{Options: opts}, nil
+}
+
+// Put stores the content of r under key and returns the number of bytes written.
+func (l *Local) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, fmt.Errorf("create blob dir: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("create temp blob: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return n, fmt.Errorf("write blob: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return n, fmt.Errorf("close blob: %v", err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return n, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return n, fmt.Errorf("commit blob: %v", err)
+	}
+
+	return n, nil
+}
+
+func (l *Local) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("open blob: %v", err)
+	}
+
+	return f, nil
+}
+
+// Delete removes the blob, a missing blob is not an error.
+func (l *Local) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob: %v", err)
+	}
+
+	return nil
+}
+
+// path spreads blobs over sub-directories by the first two key characters.
+func (l *Local) path(key string) (string, error) {
+	if len(key) < 3 || !filepath.IsLocal(key) || filepath.Base(key) != key {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+
+	return filepath.Join(l.dir, key[:2], key), nil
+}
@@ -0,0 +1,41 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package blobstore
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	dir string,
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.dir = dir
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("dir", _validate_Options_dir(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_dir(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.dir, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `dir` did not pass the test: %w", err)
+	}
+	return nil
+}
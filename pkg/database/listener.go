@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Listen subscribes a dedicated connection to the channel and calls handle
+// once the subscription is active and then on every notification. It returns
+// when ctx is done, handle fails or the connection breaks, notifications sent
+// while nobody listens are lost, so handle should read the state itself
+// instead of relying on payloads.
+func (db *Database) Listen(ctx context.Context, channel string, handle func(context.Context) error) error {
+	pooled, err := db.p.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listen connection: %w", err)
+	}
+
+	// the connection keeps listening, so it must not go back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %s: %w", channel, err)
+	}
+
+	for {
+		if err := handle(ctx); err != nil {
+			return err
+		}
+
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+	}
+}
@@ -16,16 +16,21 @@ type Tx interface {
 }
 
 func (db *Database) RunInTx(ctx context.Context, f func(context.Context) error) error {
-	tx := TxFromContext(ctx)
-	if tx == nil {
-		newTx, err := db.p.Begin(ctx)
-		if err != nil {
-			return err
-		}
+	// nested calls join the outer transaction, which is committed
+	// or rolled back by the call that began it
+	if TxFromContext(ctx) != nil {
+		return f(ctx)
+	}
 
-		tx = newTx
-		ctx = NewTxContext(ctx, tx)
+	tx, err := db.p.Begin(ctx)
+	if err != nil {
+		return err
 	}
+	ctx = NewTxContext(ctx, tx)
+
+	var hooks []func(context.Context) error
+	ctx = context.WithValue(ctx, beforeCommitKey{}, &hooks)
+
 	defer func() {
 		if v := recover(); v != nil {
 			if err := tx.Rollback(ctx); err != nil {
@@ -35,7 +40,12 @@ func (db *Database) RunInTx(ctx context.Context, f func(context.Context) error)
 		}
 	}()
 
-	if err := f(ctx); err != nil {
+	err = f(ctx)
+	// hooks may register further hooks
+	for i := 0; err == nil && i < len(hooks); i++ {
+		err = hooks[i](ctx)
+	}
+	if err != nil {
 		if rerr := tx.Rollback(ctx); rerr != nil {
 			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
 		}
@@ -47,6 +57,21 @@ func (db *Database) RunInTx(ctx context.Context, f func(context.Context) error)
 
 type txCtxKey struct{}
 
+type beforeCommitKey struct{}
+
+// BeforeCommit runs f in the transaction of ctx right before it is
+// committed, after the rest of the transaction. The transaction is rolled
+// back when f fails. Outside of a transaction f runs at once.
+func BeforeCommit(ctx context.Context, f func(context.Context) error) error {
+	hooks, ok := ctx.Value(beforeCommitKey{}).(*[]func(context.Context) error)
+	if !ok {
+		return f(ctx)
+	}
+
+	*hooks = append(*hooks, f)
+	return nil
+}
+
 func TxFromContext(ctx context.Context) pgx.Tx {
 	tx, _ := ctx.Value(txCtxKey{}).(pgx.Tx)
 
@@ -0,0 +1,228 @@
+// Package diff builds line-based unified diffs using the Myers algorithm.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultContext = 3
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type edit struct {
+	kind opKind
+	// a and b are 0-based line positions in the old and new text
+	a, b int
+}
+
+// Unified returns a unified diff of two texts with three lines of context.
+// It returns an empty string when the texts are equal.
+func Unified(fromName, toName, from, to string) string {
+	a, b := splitLines(from), splitLines(to)
+
+	edits := myers(a, b)
+
+	hunks := groupHunks(edits, defaultContext)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for _, h := range hunks {
+		writeHunk(&sb, h, a, b)
+	}
+
+	return sb.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// myers returns the shortest edit script turning a into b.
+func myers(a, b []string) []edit {
+	n, m := len(a), len(b)
+	maxD := n + m
+	if maxD == 0 {
+		return nil
+	}
+
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	trace := make([][]int, 0, maxD+1)
+
+search:
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	edits := make([]edit, 0, n+m)
+	x, y := n, m
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{kind: opEqual, a: x, b: y})
+		}
+
+		if d == 0 {
+			break
+		}
+
+		if x == prevX {
+			y--
+			edits = append(edits, edit{kind: opInsert, a: x, b: y})
+		} else {
+			x--
+			edits = append(edits, edit{kind: opDelete, a: x, b: y})
+		}
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+
+	return edits
+}
+
+type hunk struct {
+	edits []edit
+}
+
+// groupHunks splits the edit script into hunks of changes surrounded by
+// up to context equal lines, merging hunks whose context overlaps.
+func groupHunks(edits []edit, context int) []hunk {
+	var (
+		hunks []hunk
+		start = -1
+		end   = -1
+	)
+
+	for i, e := range edits {
+		if e.kind == opEqual {
+			continue
+		}
+
+		from := max(i-context, 0)
+		if start >= 0 && from <= end+1 {
+			end = min(i+context, len(edits)-1)
+			continue
+		}
+
+		if start >= 0 {
+			hunks = append(hunks, hunk{edits: edits[start : end+1]})
+		}
+
+		start = from
+		end = min(i+context, len(edits)-1)
+	}
+
+	if start >= 0 {
+		hunks = append(hunks, hunk{edits: edits[start : end+1]})
+	}
+
+	return hunks
+}
+
+func writeHunk(sb *strings.Builder, h hunk, a, b []string) {
+	var aCount, bCount int
+	for _, e := range h.edits {
+		switch e.kind {
+		case opEqual:
+			aCount++
+			bCount++
+		case opDelete:
+			aCount++
+		case opInsert:
+			bCount++
+		}
+	}
+
+	first := h.edits[0]
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(first.a, aCount), hunkRange(first.b, bCount))
+
+	for _, e := range h.edits {
+		switch e.kind {
+		case opEqual:
+			writeLine(sb, ' ', a[e.a])
+		case opDelete:
+			writeLine(sb, '-', a[e.a])
+		case opInsert:
+			writeLine(sb, '+', b[e.b])
+		}
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func writeLine(sb *strings.Builder, prefix byte, line string) {
+	sb.WriteByte(prefix)
+	sb.WriteString(line)
+
+	if !strings.HasSuffix(line, "\n") {
+		sb.WriteString("\n\\ No newline at end of file\n")
+	}
+}
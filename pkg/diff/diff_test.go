@@ -0,0 +1,126 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     string
+	}{
+		{
+			name: "equal",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: "",
+		},
+		{
+			name: "both empty",
+			want: "",
+		},
+		{
+			name: "from empty",
+			to:   "x\ny\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -0,0 +1,2 @@\n" +
+				"+x\n" +
+				"+y\n",
+		},
+		{
+			name: "to empty",
+			from: "x\ny\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,2 +0,0 @@\n" +
+				"-x\n" +
+				"-y\n",
+		},
+		{
+			name: "no trailing newline on both",
+			from: "a\nb",
+			to:   "a\nc",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"\\ No newline at end of file\n" +
+				"+c\n" +
+				"\\ No newline at end of file\n",
+		},
+		{
+			name: "trailing newline added",
+			from: "a\nb",
+			to:   "a\nb\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"\\ No newline at end of file\n" +
+				"+b\n",
+		},
+		{
+			name: "line appended after context",
+			from: "1\n2\n3\n4\n5\n6\n7\n8\n",
+			to:   "1\n2\n3\n4\n5\n6\n7\n8\nnew\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -6,3 +6,4 @@\n" +
+				" 6\n" +
+				" 7\n" +
+				" 8\n" +
+				"+new\n",
+		},
+		{
+			name: "distant changes make two hunks",
+			from: "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n",
+			to:   "a\nB\nc\nd\ne\nf\ng\nh\ni\nJ\nk\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,5 +1,5 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"+B\n" +
+				" c\n" +
+				" d\n" +
+				" e\n" +
+				"@@ -7,5 +7,5 @@\n" +
+				" g\n" +
+				" h\n" +
+				" i\n" +
+				"-j\n" +
+				"+J\n" +
+				" k\n",
+		},
+		{
+			name: "close changes share a hunk",
+			from: "a\nb\nc\nd\ne\nf\ng\nh\n",
+			to:   "a\nB\nc\nd\ne\nF\ng\nh\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1,8 +1,8 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"+B\n" +
+				" c\n" +
+				" d\n" +
+				" e\n" +
+				"-f\n" +
+				"+F\n" +
+				" g\n" +
+				" h\n",
+		},
+		{
+			name: "single line file",
+			from: "a\n",
+			to:   "b\n",
+			want: "--- a\n+++ b\n" +
+				"@@ -1 +1 @@\n" +
+				"-a\n" +
+				"+b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("a", "b", tt.from, tt.to); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,34 +3,88 @@ package grpcx
 import (
 	"context"
 	"slices"
+	"strings"
 
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/jwtx"
 )
 
-var MockToken = "Bearer 304346c6-34ee-4624-a85e-a1b198626158"
+const bearerPrefix = "bearer "
+
+type TokenVerifier interface {
+	Verify(token string) (jwtx.Claims, error)
+}
+
+// AuthInterceptor verifies the bearer token of every method except
+// the listed public ones and puts its claims into the context.
+func AuthInterceptor(verifier TokenVerifier, publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is AuthInterceptor for streaming methods.
+func StreamAuthInterceptor(verifier TokenVerifier, publicMethods ...string) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		wrapped := middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
 
-func AuthInterceptor(
-	ctx context.Context,
-	req any,
-	info *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler,
-) (res any, err error) {
+func authenticate(ctx context.Context, verifier TokenVerifier) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "md from incoming request")
 	}
 
 	headers := md.Get("authorization")
+	if len(headers) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "metadata must contain one authorization header")
+	}
+
+	header := headers[0]
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "authorization header must contain a bearer token")
+	}
 
-	if !slices.Contains(headers, MockToken) {
-		return nil, status.Error(
-			codes.Unauthenticated,
-			"metadata doesn't contain correct authorization token",
-		)
+	claims, err := verifier.Verify(header[len(bearerPrefix):])
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "verify token: %v", err)
 	}
 
-	return handler(ctx, req)
+	return jwtx.NewContext(ctx, claims), nil
 }
@@ -0,0 +1,82 @@
+package grpcx
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/jwtx"
+)
+
+// Policy restricts calls of a method by the claims of the verified token.
+type Policy struct {
+	// Roles lists roles the token needs one of, empty allows any role.
+	Roles []string
+	// Scopes lists scopes the token needs all of.
+	Scopes []string
+}
+
+func (p Policy) check(claims jwtx.Claims) error {
+	if len(p.Roles) > 0 && !slices.ContainsFunc(p.Roles, claims.HasRole) {
+		return status.Errorf(codes.PermissionDenied, "method requires one of roles %v", p.Roles)
+	}
+
+	for _, scope := range p.Scopes {
+		if !claims.HasScope(scope) {
+			return status.Errorf(codes.PermissionDenied, "method requires scope %q", scope)
+		}
+	}
+
+	return nil
+}
+
+// PolicyInterceptor enforces policies keyed by full method names, it goes
+// after AuthInterceptor. Methods without a policy are open to any verified
+// token, calls of public methods carry no token and are not checked.
+func PolicyInterceptor(policies map[string]Policy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := authorize(ctx, policies, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamPolicyInterceptor is PolicyInterceptor for streaming methods.
+func StreamPolicyInterceptor(policies map[string]Policy) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := authorize(ss.Context(), policies, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, policies map[string]Policy, method string) error {
+	claims, ok := jwtx.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	policy, ok := policies[method]
+	if !ok {
+		return nil
+	}
+
+	return policy.check(claims)
+}
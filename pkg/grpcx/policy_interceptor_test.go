@@ -0,0 +1,111 @@
+package grpcx
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evgeniy-krivenko/grpc-notes/pkg/jwtx"
+)
+
+const testMethod = "/notes.v1.NoteService/GetNote"
+
+func callUnary(ctx context.Context, policies map[string]Policy) error {
+	_, err := PolicyInterceptor(policies)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(context.Context, any) (any, error) { return nil, nil },
+	)
+
+	return err
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testStream) Context() context.Context { return s.ctx }
+
+func callStream(ctx context.Context, policies map[string]Policy) error {
+	return StreamPolicyInterceptor(policies)(nil, testStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: testMethod},
+		func(any, grpc.ServerStream) error { return nil },
+	)
+}
+
+func TestPolicyInterceptorRoles(t *testing.T) {
+	policies := map[string]Policy{testMethod: {Roles: []string{"admin"}, Scopes: []string{"notes:write"}}}
+
+	tests := []struct {
+		name     string
+		claims   *jwtx.Claims
+		expected codes.Code
+	}{
+		{
+			name:     "admin",
+			claims:   &jwtx.Claims{Roles: []string{"user", "admin"}, Scope: "notes:write"},
+			expected: codes.OK,
+		},
+		{
+			name:     "without the role",
+			claims:   &jwtx.Claims{Roles: []string{"user"}, Scope: "notes:write"},
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "without roles",
+			claims:   &jwtx.Claims{Scope: "notes:write"},
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "admin without the scope",
+			claims:   &jwtx.Claims{Roles: []string{"admin"}},
+			expected: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := jwtx.NewContext(context.Background(), *tt.claims)
+
+			if got := status.Code(callUnary(ctx, policies)); got != tt.expected {
+				t.Errorf("unary call code = %v, want %v", got, tt.expected)
+			}
+			if got := status.Code(callStream(ctx, policies)); got != tt.expected {
+				t.Errorf("streaming call code = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyInterceptorScopes(t *testing.T) {
+	policies := map[string]Policy{testMethod: {Scopes: []string{"notes:read"}}}
+
+	tests := []struct {
+		name     string
+		claims   *jwtx.Claims
+		expected codes.Code
+	}{
+		{name: "scope granted", claims: &jwtx.Claims{Scope: "notes:read notes:write"}, expected: codes.OK},
+		{name: "missing scope claim", claims: &jwtx.Claims{}, expected: codes.PermissionDenied},
+		{name: "other scope", claims: &jwtx.Claims{Scope: "notes:write"}, expected: codes.PermissionDenied},
+		{name: "scope prefix", claims: &jwtx.Claims{Scope: "notes:readonly"}, expected: codes.PermissionDenied},
+		{name: "public method", expected: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = jwtx.NewContext(ctx, *tt.claims)
+			}
+
+			if got := status.Code(callUnary(ctx, policies)); got != tt.expected {
+				t.Errorf("unary call code = %v, want %v", got, tt.expected)
+			}
+			if got := status.Code(callStream(ctx, policies)); got != tt.expected {
+				t.Errorf("streaming call code = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package jwtx
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+var ErrUnsupportedKey = errors.New("unsupported key")
+
+// Key verifies signatures of tokens made with one algorithm. Tokens with
+// a kid header are verified by the key with the same ID only.
+type Key struct {
+	ID  string
+	Alg string
+	// Key is a []byte secret for HS256, *rsa.PublicKey for RS256
+	// and *ecdsa.PublicKey on the P-256 curve for ES256.
+	Key any
+}
+
+func HMACKey(secret []byte) Key {
+	return Key{Alg: AlgHS256, Key: secret}
+}
+
+// ParsePublicKeyPEM parses a PKIX public key or a certificate,
+// RSA keys verify RS256 and P-256 keys verify ES256 tokens.
+func ParsePublicKeyPEM(data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, fmt.Errorf("%w: no PEM block", ErrUnsupportedKey)
+	}
+
+	var pub any
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return Key{}, fmt.Errorf("parse certificate: %v", err)
+		}
+		pub = cert.PublicKey
+	default:
+		var err error
+		if pub, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return Key{}, fmt.Errorf("parse public key: %v", err)
+		}
+	}
+
+	return publicKey(pub)
+}
+
+func publicKey(pub any) (Key, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return Key{Alg: AlgRS256, Key: pub}, nil
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return Key{}, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, pub.Curve.Params().Name)
+		}
+		return Key{Alg: AlgES256, Key: pub}, nil
+	default:
+		return Key{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, pub)
+	}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	// oct
+	K string `json:"k"`
+}
+
+// ParseJWKS parses a JSON Web Key Set. Encryption keys are skipped,
+// keys of other types and algorithms are rejected.
+func ParseJWKS(data []byte) ([]Key, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %v", err)
+	}
+
+	keys := make([]Key, 0, len(set.Keys))
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.key()
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %d: %w", i, err)
+		}
+		if k.Alg != "" && k.Alg != key.Alg {
+			return nil, fmt.Errorf("jwks key %d: %w: alg %s", i, ErrUnsupportedKey, k.Alg)
+		}
+		key.ID = k.Kid
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (k jwk) key() (Key, error) {
+	switch k.Kty {
+	case "oct":
+		secret, err := decodeSegment("k", k.K)
+		if err != nil {
+			return Key{}, err
+		}
+		return HMACKey(secret), nil
+
+	case "RSA":
+		n, err := decodeInt("n", k.N)
+		if err != nil {
+			return Key{}, err
+		}
+		e, err := decodeInt("e", k.E)
+		if err != nil {
+			return Key{}, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return Key{}, fmt.Errorf("%w: exponent is too large", ErrUnsupportedKey)
+		}
+		return publicKey(&rsa.PublicKey{N: n, E: int(e.Int64())})
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return Key{}, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, k.Crv)
+		}
+		x, err := decodeInt("x", k.X)
+		if err != nil {
+			return Key{}, err
+		}
+		y, err := decodeInt("y", k.Y)
+		if err != nil {
+			return Key{}, err
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return Key{}, fmt.Errorf("%w: point is not on the curve", ErrUnsupportedKey)
+		}
+		return publicKey(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+
+	default:
+		return Key{}, fmt.Errorf("%w: kty %q", ErrUnsupportedKey, k.Kty)
+	}
+}
+
+func decodeInt(name, s string) (*big.Int, error) {
+	b, err := decodeSegment(name, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
+
+func decodeSegment(name, s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("%w: invalid %s", ErrUnsupportedKey, name)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,267 @@
+package jwtx
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func marshalPublicKeyPEM(t *testing.T, pub any) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestParsePublicKeyPEM(t *testing.T) {
+	keys := newTestKeys(t)
+
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ed25519 key: %v", err)
+	}
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ecdsa key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &keys.ecdsa.PublicKey, keys.ecdsa)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantAlg string
+		wantErr error
+	}{
+		{name: "rsa", data: marshalPublicKeyPEM(t, &keys.rsa.PublicKey), wantAlg: AlgRS256},
+		{name: "ecdsa", data: marshalPublicKeyPEM(t, &keys.ecdsa.PublicKey), wantAlg: AlgES256},
+		{
+			name:    "certificate",
+			data:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+			wantAlg: AlgES256,
+		},
+		{name: "ed25519", data: marshalPublicKeyPEM(t, edPub), wantErr: ErrUnsupportedKey},
+		{name: "p-384", data: marshalPublicKeyPEM(t, &p384.PublicKey), wantErr: ErrUnsupportedKey},
+		{name: "not pem", data: []byte("secret"), wantErr: ErrUnsupportedKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePublicKeyPEM(tt.data)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParsePublicKeyPEM() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePublicKeyPEM() error = %v", err)
+			}
+
+			if key.Alg != tt.wantAlg {
+				t.Errorf("Alg = %s, want %s", key.Alg, tt.wantAlg)
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	keys := newTestKeys(t)
+
+	rsaJWK := map[string]string{
+		"kty": "RSA",
+		"kid": "rsa-1",
+		"alg": AlgRS256,
+		"use": "sig",
+		"n":   b64(keys.rsa.N.Bytes()),
+		"e":   b64(big.NewInt(int64(keys.rsa.E)).Bytes()),
+	}
+	ecJWK := map[string]string{
+		"kty": "EC",
+		"kid": "ec-1",
+		"crv": "P-256",
+		"x":   b64(keys.ecdsa.X.FillBytes(make([]byte, 32))),
+		"y":   b64(keys.ecdsa.Y.FillBytes(make([]byte, 32))),
+	}
+	octJWK := map[string]string{
+		"kty": "oct",
+		"kid": "oct-1",
+		"k":   b64(keys.hmac),
+	}
+	encJWK := map[string]string{
+		"kty": "RSA",
+		"use": "enc",
+		"n":   rsaJWK["n"],
+		"e":   rsaJWK["e"],
+	}
+
+	with := func(k map[string]string, field, value string) map[string]string {
+		c := make(map[string]string, len(k)+1)
+		for f, v := range k {
+			c[f] = v
+		}
+		c[field] = value
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		keys    []map[string]string
+		wantIDs []string
+		wantErr error
+	}{
+		{
+			name:    "rsa, ec and oct keys",
+			keys:    []map[string]string{rsaJWK, ecJWK, octJWK},
+			wantIDs: []string{"rsa-1", "ec-1", "oct-1"},
+		},
+		{
+			name:    "encryption keys are skipped",
+			keys:    []map[string]string{encJWK, ecJWK},
+			wantIDs: []string{"ec-1"},
+		},
+		{
+			name:    "alg mismatch",
+			keys:    []map[string]string{with(ecJWK, "alg", AlgRS256)},
+			wantErr: ErrUnsupportedKey,
+		},
+		{
+			name:    "unsupported alg",
+			keys:    []map[string]string{with(rsaJWK, "alg", "RS512")},
+			wantErr: ErrUnsupportedKey,
+		},
+		{
+			name:    "unsupported curve",
+			keys:    []map[string]string{with(ecJWK, "crv", "P-384")},
+			wantErr: ErrUnsupportedKey,
+		},
+		{
+			name:    "point not on the curve",
+			keys:    []map[string]string{with(ecJWK, "y", b64(big.NewInt(1).FillBytes(make([]byte, 32))))},
+			wantErr: ErrUnsupportedKey,
+		},
+		{
+			name:    "unsupported kty",
+			keys:    []map[string]string{with(octJWK, "kty", "OKP")},
+			wantErr: ErrUnsupportedKey,
+		},
+		{
+			name:    "invalid modulus",
+			keys:    []map[string]string{with(rsaJWK, "n", "!")},
+			wantErr: ErrUnsupportedKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(map[string]any{"keys": tt.keys})
+			if err != nil {
+				t.Fatalf("marshal jwks: %v", err)
+			}
+
+			got, err := ParseJWKS(data)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseJWKS() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseJWKS() error = %v", err)
+			}
+
+			var ids []string
+			for _, k := range got {
+				ids = append(ids, k.ID)
+			}
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("key ids = %v, want %v", ids, tt.wantIDs)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Errorf("key ids = %v, want %v", ids, tt.wantIDs)
+				}
+			}
+		})
+	}
+
+	if _, err := ParseJWKS([]byte("{")); err == nil {
+		t.Error("ParseJWKS() of invalid JSON error = nil")
+	}
+}
+
+// TestParseJWKSVerify checks that the keys of a set verify tokens made
+// with the private keys.
+func TestParseJWKSVerify(t *testing.T) {
+	keys := newTestKeys(t)
+
+	data, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{
+			"kty": "RSA",
+			"kid": "rsa-1",
+			"n":   b64(keys.rsa.N.Bytes()),
+			"e":   b64(big.NewInt(int64(keys.rsa.E)).Bytes()),
+		},
+		{
+			"kty": "EC",
+			"kid": "ec-1",
+			"crv": "P-256",
+			"x":   b64(keys.ecdsa.X.FillBytes(make([]byte, 32))),
+			"y":   b64(keys.ecdsa.Y.FillBytes(make([]byte, 32))),
+		},
+	}})
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+
+	set, err := ParseJWKS(data)
+	if err != nil {
+		t.Fatalf("ParseJWKS() error = %v", err)
+	}
+
+	v, err := NewVerifier(NewOptions(WithKeys(set...)))
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	for _, token := range []string{
+		sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa-1", claims(nil)),
+		sign(t, jwt.SigningMethodES256, keys.ecdsa, "ec-1", claims(nil)),
+		sign(t, jwt.SigningMethodES256, keys.ecdsa, "", claims(nil)),
+	} {
+		if _, err := v.Verify(token); err != nil {
+			t.Errorf("Verify() error = %v", err)
+		}
+	}
+
+	if _, err := v.Verify(sign(t, jwt.SigningMethodES256, keys.otherEC, "ec-1", claims(nil))); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify() of a token of another key error = %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,128 @@
+// Package jwtx verifies JWT bearer tokens signed with HS256, RS256 or ES256.
+package jwtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles jwt.ClaimStrings `json:"roles,omitempty"`
+	// Scope is a space separated list of granted scopes.
+	Scope string `json:"scope,omitempty"`
+}
+
+func (c Claims) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// HasScope tells whether the token grants the scope,
+// tokens without the scope claim grant none.
+func (c Claims) HasScope(scope string) bool {
+	return slices.Contains(strings.Fields(c.Scope), scope)
+}
+
+//go:generate options-gen -out-filename=verifier_options.gen.go -from-struct=Options -all-variadic true
+type Options struct {
+	keys []Key `validate:"required,min=1"`
+
+	// issuer is checked when set.
+	issuer string
+	// audience is checked when set.
+	audience string
+	// leeway tolerates clock skew with the token issuer.
+	leeway time.Duration `default:"30s" validate:"min=0"`
+}
+
+// Verifier checks signatures and exp, nbf, iss and aud claims of tokens,
+// tokens without exp are rejected.
+type Verifier struct {
+	Options
+	parser *jwt.Parser
+}
+
+func NewVerifier(opts Options) (*Verifier, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate jwt verifier options: %v", err)
+	}
+
+	var algs []string
+	for _, k := range opts.keys {
+		switch k.Alg {
+		case AlgHS256, AlgRS256, AlgES256:
+		default:
+			return nil, fmt.Errorf("%w: alg %q", ErrUnsupportedKey, k.Alg)
+		}
+		if !slices.Contains(algs, k.Alg) {
+			algs = append(algs, k.Alg)
+		}
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(algs),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(opts.leeway),
+	}
+	if opts.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.issuer))
+	}
+	if opts.audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.audience))
+	}
+
+	return &Verifier{
+		Options: opts,
+		parser:  jwt.NewParser(parserOpts...),
+	}, nil
+}
+
+func (v *Verifier) Verify(token string) (Claims, error) {
+	var claims Claims
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.key); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return claims, nil
+}
+
+// key picks the keys for the algorithm of the token, the kid header
+// narrows them down to one.
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	alg := token.Method.Alg()
+	kid, _ := token.Header["kid"].(string)
+
+	var set jwt.VerificationKeySet
+	for _, k := range v.keys {
+		if k.Alg != alg || kid != "" && k.ID != kid {
+			continue
+		}
+		set.Keys = append(set.Keys, k.Key)
+	}
+
+	if len(set.Keys) == 0 {
+		return nil, fmt.Errorf("no %s key with id %q", alg, kid)
+	}
+
+	return set, nil
+}
+
+type claimsKey struct{}
+
+// NewContext returns ctx carrying the verified claims.
+func NewContext(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+func FromContext(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(Claims)
+	return claims, ok
+}
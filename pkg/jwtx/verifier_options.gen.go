@@ -0,0 +1,68 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package jwtx
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.leeway, _ = time.ParseDuration("30s")
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithKeys(opt ...Key) OptOptionsSetter {
+	return func(o *Options) { o.keys = append(o.keys, opt...) }
+}
+
+// issuer is checked when set.
+func WithIssuer(opt string) OptOptionsSetter {
+	return func(o *Options) { o.issuer = opt }
+}
+
+// audience is checked when set.
+func WithAudience(opt string) OptOptionsSetter {
+	return func(o *Options) { o.audience = opt }
+}
+
+// leeway tolerates clock skew with the token issuer.
+func WithLeeway(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.leeway = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("keys", _validate_Options_keys(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("leeway", _validate_Options_leeway(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_keys(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.keys, "required,min=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `keys` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_leeway(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.leeway, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `leeway` did not pass the test: %w", err)
+	}
+	return nil
+}
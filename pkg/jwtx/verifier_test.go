@@ -0,0 +1,293 @@
+package jwtx
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testKeys are throwaway signing keys generated for the tests.
+type testKeys struct {
+	hmac    []byte
+	rsa     *rsa.PrivateKey
+	ecdsa   *ecdsa.PrivateKey
+	otherEC *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ecdsa key: %v", err)
+	}
+	otherEC, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ecdsa key: %v", err)
+	}
+
+	return testKeys{
+		hmac:    []byte("0123456789abcdef0123456789abcdef"),
+		rsa:     rsaKey,
+		ecdsa:   ecKey,
+		otherEC: otherEC,
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.Claims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	return s
+}
+
+func claims(mutate func(*Claims)) Claims {
+	now := time.Now()
+
+	c := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "42",
+			Issuer:    "https://issuer.test",
+			Audience:  jwt.ClaimStrings{"notes"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now.Add(-time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Roles: jwt.ClaimStrings{"admin"},
+	}
+	if mutate != nil {
+		mutate(&c)
+	}
+
+	return c
+}
+
+func TestVerify(t *testing.T) {
+	keys := newTestKeys(t)
+
+	v, err := NewVerifier(NewOptions(
+		WithKeys(
+			HMACKey(keys.hmac),
+			Key{ID: "rsa-1", Alg: AlgRS256, Key: &keys.rsa.PublicKey},
+			Key{ID: "ec-1", Alg: AlgES256, Key: &keys.ecdsa.PublicKey},
+		),
+		WithIssuer("https://issuer.test"),
+		WithAudience("notes"),
+		WithLeeway(time.Minute),
+	))
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		wantErr bool
+	}{
+		{
+			name: "HS256",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(nil))
+			},
+		},
+		{
+			name: "RS256",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodRS256, keys.rsa, "", claims(nil))
+			},
+		},
+		{
+			name: "RS256 with kid",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa-1", claims(nil))
+			},
+		},
+		{
+			name: "ES256",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodES256, keys.ecdsa, "ec-1", claims(nil))
+			},
+		},
+		{
+			name: "expired within leeway",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-30 * time.Second))
+				}))
+			},
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "without exp",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.ExpiresAt = nil
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "not valid yet",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour))
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "other issuer",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.Issuer = "https://evil.test"
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "other audience",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS256, keys.hmac, "", claims(func(c *Claims) {
+					c.Audience = jwt.ClaimStrings{"billing"}
+				}))
+			},
+			wantErr: true,
+		},
+		{
+			name: "other key",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodES256, keys.otherEC, "", claims(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown kid",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa-2", claims(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "kid of a key with another alg",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodES256, keys.ecdsa, "rsa-1", claims(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "alg not configured",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodHS512, keys.hmac, "", claims(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "alg none",
+			token: func(t *testing.T) string {
+				return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", claims(nil))
+			},
+			wantErr: true,
+		},
+		{
+			name: "garbage",
+			token: func(*testing.T) string {
+				return "not.a.token"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Verify(tt.token(t))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("Verify() error = %v, want %v", err, ErrInvalidToken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if got.Subject != "42" || !got.HasRole("admin") {
+				t.Errorf("Verify() = %+v, want subject 42 with the admin role", got)
+			}
+		})
+	}
+}
+
+// TestVerifyAlgMismatch checks that a token signed with HS256 and the RSA
+// public key as the secret is rejected by a verifier of RS256 tokens.
+func TestVerifyAlgMismatch(t *testing.T) {
+	keys := newTestKeys(t)
+
+	pemKey := marshalPublicKeyPEM(t, &keys.rsa.PublicKey)
+	key, err := ParsePublicKeyPEM(pemKey)
+	if err != nil {
+		t.Fatalf("ParsePublicKeyPEM() error = %v", err)
+	}
+
+	v, err := NewVerifier(NewOptions(WithKeys(key)))
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+
+	token := sign(t, jwt.SigningMethodHS256, pemKey, "", claims(nil))
+	if _, err := v.Verify(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify() error = %v, want %v", err, ErrInvalidToken)
+	}
+
+	token = sign(t, jwt.SigningMethodRS256, keys.rsa, "", claims(nil))
+	if _, err := v.Verify(token); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []Key
+		wantErr bool
+	}{
+		{name: "no keys", wantErr: true},
+		{name: "unsupported alg", keys: []Key{{Alg: "HS512", Key: []byte("secret")}}, wantErr: true},
+		{name: "hmac", keys: []Key{HMACKey([]byte("secret"))}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVerifier(NewOptions(WithKeys(tt.keys...)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVerifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Package ot implements operational transformation of plain text.
+//
+// An operation walks the whole document and consists of components that
+// retain, insert or delete text. Lengths and positions are counted in runes.
+package ot
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidComponent  = errors.New("component must retain, insert or delete")
+	ErrBaseLenMismatch   = errors.New("operation base length does not match")
+	ErrOperationConflict = errors.New("operations have different base lengths")
+)
+
+// Component is one step of an operation, exactly one field is set.
+type Component struct {
+	Retain int    `json:"retain,omitempty"`
+	Insert string `json:"insert,omitempty"`
+	Delete int    `json:"delete,omitempty"`
+}
+
+func (c Component) isRetain() bool { return c.Retain > 0 }
+func (c Component) isInsert() bool { return c.Insert != "" }
+func (c Component) isDelete() bool { return c.Delete > 0 }
+
+// Operation is a sequence of components, adjacent components of the same
+// kind are merged by the builder methods.
+type Operation []Component
+
+// Retain appends a component keeping n runes.
+func (op Operation) Retain(n int) Operation {
+	if n <= 0 {
+		return op
+	}
+	if last := len(op) - 1; last >= 0 && op[last].isRetain() {
+		op[last].Retain += n
+		return op
+	}
+
+	return append(op, Component{Retain: n})
+}
+
+// Insert appends a component inserting s. Inserts are kept before deletes,
+// so equal operations always have the same components.
+func (op Operation) Insert(s string) Operation {
+	if s == "" {
+		return op
+	}
+
+	last := len(op) - 1
+	if last >= 0 && op[last].isInsert() {
+		op[last].Insert += s
+		return op
+	}
+	if last >= 0 && op[last].isDelete() {
+		if last > 0 && op[last-1].isInsert() {
+			op[last-1].Insert += s
+			return op
+		}
+		op = append(op, op[last])
+		op[last] = Component{Insert: s}
+		return op
+	}
+
+	return append(op, Component{Insert: s})
+}
+
+// Delete appends a component deleting n runes.
+func (op Operation) Delete(n int) Operation {
+	if n <= 0 {
+		return op
+	}
+	if last := len(op) - 1; last >= 0 && op[last].isDelete() {
+		op[last].Delete += n
+		return op
+	}
+
+	return append(op, Component{Delete: n})
+}
+
+// Validate checks that every component does exactly one thing.
+func (op Operation) Validate() error {
+	for i, c := range op {
+		set := 0
+		if c.Retain != 0 {
+			set++
+		}
+		if c.Insert != "" {
+			set++
+		}
+		if c.Delete != 0 {
+			set++
+		}
+		if set != 1 || c.Retain < 0 || c.Delete < 0 {
+			return fmt.Errorf("component %d: %w", i, ErrInvalidComponent)
+		}
+	}
+
+	return nil
+}
+
+// BaseLen is the length of the document the operation applies to.
+func (op Operation) BaseLen() int {
+	n := 0
+	for _, c := range op {
+		n += c.Retain + c.Delete
+	}
+
+	return n
+}
+
+// TargetLen is the length of the document after the operation.
+func (op Operation) TargetLen() int {
+	n := 0
+	for _, c := range op {
+		n += c.Retain + utf8.RuneCountInString(c.Insert)
+	}
+
+	return n
+}
+
+// IsNoop tells whether the operation leaves the document as is.
+func (op Operation) IsNoop() bool {
+	for _, c := range op {
+		if !c.isRetain() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Apply returns the document changed by the operation.
+func Apply(doc string, op Operation) (string, error) {
+	runes := []rune(doc)
+	if op.BaseLen() != len(runes) {
+		return "", fmt.Errorf("%w: %d, document length %d", ErrBaseLenMismatch, op.BaseLen(), len(runes))
+	}
+
+	out := make([]rune, 0, op.TargetLen())
+	pos := 0
+	for _, c := range op {
+		switch {
+		case c.isRetain():
+			out = append(out, runes[pos:pos+c.Retain]...)
+			pos += c.Retain
+		case c.isInsert():
+			out = append(out, []rune(c.Insert)...)
+		case c.isDelete():
+			pos += c.Delete
+		}
+	}
+
+	return string(out), nil
+}
+
+// Transform takes concurrent operations a and b made on the same document
+// and returns a' and b', such that applying a then b' gives the same
+// document as applying b then a'. Text inserted by a at the same position
+// as text inserted by b goes first.
+func Transform(a, b Operation) (Operation, Operation, error) {
+	if a.BaseLen() != b.BaseLen() {
+		return nil, nil, fmt.Errorf("%w: %d and %d", ErrOperationConflict, a.BaseLen(), b.BaseLen())
+	}
+
+	var aPrime, bPrime Operation
+	ia, ib := newIterator(a), newIterator(b)
+
+	for !ia.done() || !ib.done() {
+		ca, cb := ia.peek(), ib.peek()
+
+		if ca.isInsert() {
+			aPrime = aPrime.Insert(ca.Insert)
+			bPrime = bPrime.Retain(utf8.RuneCountInString(ca.Insert))
+			ia.next(0)
+			continue
+		}
+		if cb.isInsert() {
+			aPrime = aPrime.Retain(utf8.RuneCountInString(cb.Insert))
+			bPrime = bPrime.Insert(cb.Insert)
+			ib.next(0)
+			continue
+		}
+
+		n := min(ia.len(), ib.len())
+		if n == 0 {
+			return nil, nil, ErrOperationConflict
+		}
+
+		switch {
+		case ca.isRetain() && cb.isRetain():
+			aPrime = aPrime.Retain(n)
+			bPrime = bPrime.Retain(n)
+		case ca.isDelete() && cb.isRetain():
+			aPrime = aPrime.Delete(n)
+		case ca.isRetain() && cb.isDelete():
+			bPrime = bPrime.Delete(n)
+		}
+		// both delete the same text, nothing is left to delete
+
+		ia.next(n)
+		ib.next(n)
+	}
+
+	return aPrime, bPrime, nil
+}
+
+// TransformIndex moves a position in the document before the operation
+// to the same place in the document after it. Text inserted at the position
+// goes before it.
+func TransformIndex(index int, op Operation) int {
+	newIndex := index
+	for _, c := range op {
+		switch {
+		case c.isRetain():
+			index -= c.Retain
+		case c.isInsert():
+			newIndex += utf8.RuneCountInString(c.Insert)
+		case c.isDelete():
+			newIndex -= min(index, c.Delete)
+			index -= c.Delete
+		}
+
+		if index < 0 {
+			break
+		}
+	}
+
+	return newIndex
+}
+
+// Replace returns an operation turning from into to, it keeps the common
+// prefix and suffix, so positions outside of the changed text stay valid.
+func Replace(from, to string) Operation {
+	a, b := []rune(from), []rune(to)
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var op Operation
+	op = op.Retain(prefix)
+	op = op.Insert(string(b[prefix : len(b)-suffix]))
+	op = op.Delete(len(a) - prefix - suffix)
+	op = op.Retain(suffix)
+
+	return op
+}
+
+// iterator walks components of an operation, retains and deletes may be
+// consumed partially.
+type iterator struct {
+	op       Operation
+	i        int
+	consumed int
+}
+
+func newIterator(op Operation) *iterator {
+	return &iterator{op: op}
+}
+
+func (it *iterator) done() bool {
+	return it.i >= len(it.op)
+}
+
+// peek returns the rest of the current component, the zero component
+// when the operation is over.
+func (it *iterator) peek() Component {
+	if it.done() {
+		return Component{}
+	}
+
+	c := it.op[it.i]
+	switch {
+	case c.isRetain():
+		c.Retain -= it.consumed
+	case c.isDelete():
+		c.Delete -= it.consumed
+	}
+
+	return c
+}
+
+// len is the number of runes left in the current retain or delete.
+func (it *iterator) len() int {
+	c := it.peek()
+	return c.Retain + c.Delete
+}
+
+// next consumes n runes of the current retain or delete, or the whole
+// current insert.
+func (it *iterator) next(n int) {
+	if it.done() {
+		return
+	}
+
+	if it.op[it.i].isInsert() || it.len() <= n {
+		it.i++
+		it.consumed = 0
+		return
+	}
+
+	it.consumed += n
+}
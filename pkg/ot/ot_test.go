@@ -0,0 +1,302 @@
+package ot
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// transformed applies a then b' and b then a', both must give the same document.
+func transformed(t *testing.T, doc string, a, b Operation) string {
+	t.Helper()
+
+	aPrime, bPrime, err := Transform(a, b)
+	if err != nil {
+		t.Fatalf("Transform() error = %v", err)
+	}
+
+	ab := mustApply(t, mustApply(t, doc, a), bPrime)
+	ba := mustApply(t, mustApply(t, doc, b), aPrime)
+	if ab != ba {
+		t.Fatalf("documents diverge: a then b' = %q, b then a' = %q", ab, ba)
+	}
+
+	return ab
+}
+
+func mustApply(t *testing.T, doc string, op Operation) string {
+	t.Helper()
+
+	got, err := Apply(doc, op)
+	if err != nil {
+		t.Fatalf("Apply(%q, %v) error = %v", doc, op, err)
+	}
+
+	return got
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		a        Operation
+		b        Operation
+		expected string
+	}{
+		{
+			name:     "inserts at different positions",
+			doc:      "hello world",
+			a:        Operation{}.Insert(">").Retain(11),
+			b:        Operation{}.Retain(11).Insert("!"),
+			expected: ">hello world!",
+		},
+		{
+			name:     "insert tie goes to a first",
+			doc:      "ab",
+			a:        Operation{}.Retain(1).Insert("X").Retain(1),
+			b:        Operation{}.Retain(1).Insert("Y").Retain(1),
+			expected: "aXYb",
+		},
+		{
+			name:     "insert tie at the end",
+			doc:      "ab",
+			a:        Operation{}.Retain(2).Insert("X"),
+			b:        Operation{}.Retain(2).Insert("Y"),
+			expected: "abXY",
+		},
+		{
+			name:     "insert inside deleted text",
+			doc:      "abcdef",
+			a:        Operation{}.Retain(3).Insert("X").Retain(3),
+			b:        Operation{}.Retain(1).Delete(4).Retain(1),
+			expected: "aXf",
+		},
+		{
+			name:     "same delete",
+			doc:      "abcdef",
+			a:        Operation{}.Retain(1).Delete(2).Retain(3),
+			b:        Operation{}.Retain(1).Delete(2).Retain(3),
+			expected: "adef",
+		},
+		{
+			name:     "overlapping deletes",
+			doc:      "abcdef",
+			a:        Operation{}.Retain(1).Delete(3).Retain(2),
+			b:        Operation{}.Retain(2).Delete(3).Retain(1),
+			expected: "af",
+		},
+		{
+			name:     "delete inside delete",
+			doc:      "abcdef",
+			a:        Operation{}.Delete(6),
+			b:        Operation{}.Retain(2).Delete(2).Retain(2),
+			expected: "",
+		},
+		{
+			name:     "replace against replace",
+			doc:      "the cat sat",
+			a:        Replace("the cat sat", "the dog sat"),
+			b:        Replace("the cat sat", "the cat stood"),
+			expected: "the dog stood",
+		},
+		{
+			name:     "multi-byte runes",
+			doc:      "héllo 世界",
+			a:        Operation{}.Retain(1).Delete(1).Insert("e").Retain(6),
+			b:        Operation{}.Retain(6).Delete(2).Insert("мир"),
+			expected: "hello мир",
+		},
+		{
+			name:     "multi-byte insert tie",
+			doc:      "日本",
+			a:        Operation{}.Retain(1).Insert("🙂").Retain(1),
+			b:        Operation{}.Retain(1).Insert("ü").Retain(1),
+			expected: "日🙂ü本",
+		},
+		{
+			name:     "noop",
+			doc:      "abc",
+			a:        Operation{}.Retain(3),
+			b:        Operation{}.Retain(1).Delete(1).Retain(1),
+			expected: "ac",
+		},
+		{
+			name:     "empty document",
+			doc:      "",
+			a:        Operation{}.Insert("a"),
+			b:        Operation{}.Insert("b"),
+			expected: "ab",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transformed(t, tt.doc, tt.a, tt.b); got != tt.expected {
+				t.Errorf("document = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTransformConflict(t *testing.T) {
+	_, _, err := Transform(Operation{}.Retain(2), Operation{}.Retain(3))
+	if !errors.Is(err, ErrOperationConflict) {
+		t.Errorf("Transform() error = %v, want %v", err, ErrOperationConflict)
+	}
+}
+
+func TestApplyBaseLenMismatch(t *testing.T) {
+	_, err := Apply("日本", Operation{}.Retain(6))
+	if !errors.Is(err, ErrBaseLenMismatch) {
+		t.Errorf("Apply() error = %v, want %v", err, ErrBaseLenMismatch)
+	}
+}
+
+// randomOperation returns an operation of the document made of random
+// components, inserts use multi-byte runes as well.
+func randomOperation(r *rand.Rand, doc string) Operation {
+	alphabet := []rune("ab é世🙂")
+
+	var op Operation
+	left := len([]rune(doc))
+	for left > 0 || r.IntN(3) == 0 {
+		switch r.IntN(3) {
+		case 0:
+			s := make([]rune, 1+r.IntN(3))
+			for i := range s {
+				s[i] = alphabet[r.IntN(len(alphabet))]
+			}
+			op = op.Insert(string(s))
+			if left == 0 {
+				return op
+			}
+		case 1:
+			if left > 0 {
+				n := 1 + r.IntN(left)
+				op = op.Retain(n)
+				left -= n
+			}
+		case 2:
+			if left > 0 {
+				n := 1 + r.IntN(left)
+				op = op.Delete(n)
+				left -= n
+			}
+		}
+	}
+
+	return op
+}
+
+func TestTransformRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for range 2000 {
+		doc := mustApply(t, "", randomOperation(r, ""))
+		a, b := randomOperation(r, doc), randomOperation(r, doc)
+
+		transformed(t, doc, a, b)
+	}
+}
+
+func TestTransformIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		index    int
+		op       Operation
+		expected int
+	}{
+		{name: "noop", index: 2, op: Operation{}.Retain(5), expected: 2},
+		{name: "insert before", index: 2, op: Operation{}.Insert("xy").Retain(5), expected: 4},
+		{name: "insert at", index: 2, op: Operation{}.Retain(2).Insert("xy").Retain(3), expected: 4},
+		{name: "insert after", index: 2, op: Operation{}.Retain(3).Insert("xy").Retain(2), expected: 2},
+		{name: "delete before", index: 4, op: Operation{}.Delete(2).Retain(3), expected: 2},
+		{name: "delete around", index: 2, op: Operation{}.Retain(1).Delete(3).Retain(1), expected: 1},
+		{name: "delete after", index: 2, op: Operation{}.Retain(2).Delete(3), expected: 2},
+		{name: "multi-byte insert", index: 1, op: Operation{}.Insert("世界🙂").Retain(2), expected: 4},
+		{name: "end of document", index: 3, op: Operation{}.Retain(3).Insert("!"), expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransformIndex(tt.index, tt.op); got != tt.expected {
+				t.Errorf("TransformIndex(%d) = %d, want %d", tt.index, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected Operation
+	}{
+		{
+			name:     "equal",
+			from:     "abc",
+			to:       "abc",
+			expected: Operation{{Retain: 3}},
+		},
+		{
+			name:     "from empty",
+			from:     "",
+			to:       "abc",
+			expected: Operation{{Insert: "abc"}},
+		},
+		{
+			name:     "to empty",
+			from:     "abc",
+			to:       "",
+			expected: Operation{{Delete: 3}},
+		},
+		{
+			name:     "middle changed",
+			from:     "the cat sat",
+			to:       "the dog sat",
+			expected: Operation{{Retain: 4}, {Insert: "dog"}, {Delete: 3}, {Retain: 4}},
+		},
+		{
+			name:     "repeated runes",
+			from:     "aaa",
+			to:       "aaaa",
+			expected: Operation{{Retain: 3}, {Insert: "a"}},
+		},
+		{
+			name:     "multi-byte runes",
+			from:     "héllo 世界",
+			to:       "héllo 世间界",
+			expected: Operation{{Retain: 7}, {Insert: "间"}, {Retain: 1}},
+		},
+		{
+			name:     "runes sharing leading bytes",
+			from:     "日",
+			to:       "旦",
+			expected: Operation{{Insert: "旦"}, {Delete: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := Replace(tt.from, tt.to)
+			if !slices.Equal(op, tt.expected) {
+				t.Errorf("Replace() = %v, want %v", op, tt.expected)
+			}
+
+			if got := mustApply(t, tt.from, op); got != tt.to {
+				t.Errorf("Apply(Replace()) = %q, want %q", got, tt.to)
+			}
+		})
+	}
+}
+
+func TestInsertBeforeDelete(t *testing.T) {
+	a := Operation{}.Retain(1).Delete(2).Insert("x").Retain(1)
+	b := Operation{}.Retain(1).Insert("x").Delete(2).Retain(1)
+
+	if !slices.Equal(a, b) {
+		t.Errorf("%v and %v are not equal", a, b)
+	}
+}
@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrInvalidURL = errors.New("invalid webhook url")
+	// ErrBlockedAddress is returned for hosts resolving to addresses
+	// that are not public, see publicAddr.
+	ErrBlockedAddress = errors.New("webhook address is not public")
+)
+
+// nonPublicPrefixes are special purpose ranges netip does not classify,
+// the NAT64 and 6to4 ones embed IPv4 addresses.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+const (
+	// SignatureHeader carries sha256=<hex encoded HMAC-SHA256 of the body>.
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	signaturePrefix = "sha256="
+
+	// maxResponseSize is read from a response so the connection can be reused.
+	maxResponseSize = 64 << 10
+)
+
+//go:generate options-gen -out-filename=sender_options.gen.go -from-struct=Options
+type Options struct {
+	timeout time.Duration `default:"10s" validate:"min=100ms"`
+	// allowPrivateAddresses lets webhooks call loopback, private and other
+	// non-public addresses, it is meant for local development only.
+	allowPrivateAddresses bool
+}
+
+// Sender posts signed JSON payloads. It makes a single call, retries are
+// up to the caller, see Retryable. Webhooks call public addresses only,
+// the address is checked after the host is resolved, so a name cannot
+// point the sender at internal services.
+type Sender struct {
+	Options
+	client *http.Client
+}
+
+func NewSender(opts Options) (*Sender, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate webhook sender options: %v", err)
+	}
+
+	s := &Sender{Options: opts}
+
+	dialer := &net.Dialer{
+		Timeout: opts.timeout,
+		Control: s.checkDial,
+	}
+
+	s.client = &http.Client{
+		Timeout: opts.timeout,
+		// no proxy, the sender has to see the address it connects to
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		// a redirect is reported as a failure instead of turning
+		// the POST into a GET
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return s, nil
+}
+
+// ValidateURL checks that the URL is an http or https URL of a host that
+// resolves to public addresses. Names may resolve differently later, Send
+// checks the address it connects to anyway.
+func (s *Sender) ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("%w: %q", ErrInvalidURL, rawURL)
+	}
+
+	if s.allowPrivateAddresses {
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: resolve %s: %v", ErrInvalidURL, u.Hostname(), err)
+	}
+
+	for _, addr := range addrs {
+		if !publicAddr(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrBlockedAddress, u.Hostname(), addr)
+		}
+	}
+
+	return nil
+}
+
+// checkDial rejects connections to addresses that are not public,
+// it is called with the resolved address.
+func (s *Sender) checkDial(_, address string, _ syscall.RawConn) error {
+	if s.allowPrivateAddresses {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+	}
+	if !publicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, addrPort.Addr())
+	}
+
+	return nil
+}
+
+// publicAddr reports whether the address is a public unicast one. Loopback,
+// private, link-local with the cloud metadata endpoints among them,
+// multicast and reserved addresses are not.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID int64
+	Body       []byte
+}
+
+// Result describes the call made by Send. StatusCode is 0 if no response
+// was received.
+type Result struct {
+	StatusCode int
+}
+
+// Send posts the request body once, it fails unless the endpoint answers
+// with 2xx.
+func (s *Sender) Send(ctx context.Context, req Request) (Result, error) {
+	code, err := s.post(ctx, req)
+	return Result{StatusCode: code}, err
+}
+
+// Retryable reports whether the failed call may succeed when made again:
+// the endpoint has not answered or has answered with 5xx, 408 or 429.
+// Calls of blocked addresses are not retried.
+func Retryable(res Result, err error) bool {
+	if err == nil || errors.Is(err, ErrBlockedAddress) {
+		return false
+	}
+
+	switch code := res.StatusCode; {
+	case code == 0, code >= 500:
+		return true
+	default:
+		return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+	}
+}
+
+func (s *Sender) post(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("create request: %v", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, req.Body))
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the SignatureHeader value of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature matches the body, receivers use it
+// to authenticate the calls.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,51 @@
+// Code generated by options-gen v0.55.3. DO NOT EDIT.
+
+package webhook
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	options ...OptOptionsSetter,
+) Options {
+	var o Options
+
+	// Setting defaults from field tag (if present)
+
+	o.timeout, _ = time.ParseDuration("10s")
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) { o.timeout = opt }
+}
+
+// allowPrivateAddresses lets webhooks call loopback, private and other
+// non-public addresses, it is meant for local development only.
+func WithAllowPrivateAddresses(opt bool) OptOptionsSetter {
+	return func(o *Options) { o.allowPrivateAddresses = opt }
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("timeout", _validate_Options_timeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_timeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.timeout, "min=100ms"); err != nil {
+		return fmt461e464ebed9.Errorf("field `timeout` did not pass the test: %w", err)
+	}
+	return nil
+}
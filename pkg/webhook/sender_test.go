@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func newSender(t *testing.T, opts ...OptOptionsSetter) *Sender {
+	t.Helper()
+
+	s, err := NewSender(NewOptions(append([]OptOptionsSetter{WithTimeout(time.Second)}, opts...)...))
+	if err != nil {
+		t.Fatalf("NewSender() error = %v", err)
+	}
+
+	return s
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "no content", status: http.StatusNoContent},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+		{name: "redirect is not followed", status: http.StatusFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := []byte(`{"sequence":1}`)
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ := io.ReadAll(r.Body)
+				if string(got) != string(body) {
+					t.Errorf("body = %s, want %s", got, body)
+				}
+				if !Verify("secret", got, r.Header.Get(SignatureHeader)) {
+					t.Errorf("%s = %q does not verify", SignatureHeader, r.Header.Get(SignatureHeader))
+				}
+				if got := r.Header.Get(EventHeader); got != "created" {
+					t.Errorf("%s = %q, want created", EventHeader, got)
+				}
+				if got := r.Header.Get(DeliveryHeader); got != "42" {
+					t.Errorf("%s = %q, want 42", DeliveryHeader, got)
+				}
+				if got := r.Header.Get("Content-Type"); got != "application/json" {
+					t.Errorf("Content-Type = %q, want application/json", got)
+				}
+
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			s := newSender(t, WithAllowPrivateAddresses(true))
+
+			res, err := s.Send(context.Background(), Request{
+				URL:        srv.URL,
+				Secret:     "secret",
+				Event:      "created",
+				DeliveryID: 42,
+				Body:       body,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if res.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", res.StatusCode, tt.status)
+			}
+		})
+	}
+}
+
+func TestSendBlockedAddress(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	s := newSender(t)
+
+	res, err := s.Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("Send() error = %v, want %v", err, ErrBlockedAddress)
+	}
+	if called {
+		t.Error("blocked address is called")
+	}
+	if Retryable(res, err) {
+		t.Error("Retryable() = true for a blocked address")
+	}
+}
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"sequence":1}`)
+
+	// echo -n '{"sequence":1}' | openssl dgst -sha256 -hmac secret
+	const want = "sha256=88201002024db60acf662a6ab8d74e497cef28e379ce1a4a903c4382553c0a8c"
+	got := Sign("secret", body)
+	if got != want {
+		t.Fatalf("Sign() = %q, want %q", got, want)
+	}
+
+	if !Verify("secret", body, got) {
+		t.Error("Verify() = false for the signature of the body")
+	}
+	if Verify("other", body, got) {
+		t.Error("Verify() = true for another secret")
+	}
+	if Verify("secret", []byte(`{"sequence":2}`), got) {
+		t.Error("Verify() = true for another body")
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	failed := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		status   int
+		err      error
+		expected bool
+	}{
+		{name: "succeeded", status: http.StatusOK},
+		{name: "no response", err: failed, expected: true},
+		{name: "server error", status: http.StatusBadGateway, err: failed, expected: true},
+		{name: "request timeout", status: http.StatusRequestTimeout, err: failed, expected: true},
+		{name: "too many requests", status: http.StatusTooManyRequests, err: failed, expected: true},
+		{name: "bad request", status: http.StatusBadRequest, err: failed},
+		{name: "gone", status: http.StatusGone, err: failed},
+		{name: "redirect", status: http.StatusFound, err: failed},
+		{name: "blocked address", err: ErrBlockedAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Retryable(Result{StatusCode: tt.status}, tt.err); got != tt.expected {
+				t.Errorf("Retryable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		allow   bool
+		wantErr error
+	}{
+		{name: "public", url: "https://93.184.215.14/hook"},
+		{name: "public ipv6", url: "https://[2606:2800:21f:cb07:6820:80da:af6b:8b2c]/hook"},
+		{name: "not http", url: "ftp://93.184.215.14/hook", wantErr: ErrInvalidURL},
+		{name: "no host", url: "https:///hook", wantErr: ErrInvalidURL},
+		{name: "loopback", url: "http://127.0.0.1:8080/hook", wantErr: ErrBlockedAddress},
+		{name: "private", url: "http://10.1.2.3/hook", wantErr: ErrBlockedAddress},
+		{name: "metadata", url: "http://169.254.169.254/latest/meta-data", wantErr: ErrBlockedAddress},
+		{name: "ipv6 loopback", url: "http://[::1]/hook", wantErr: ErrBlockedAddress},
+		{name: "mapped loopback", url: "http://[::ffff:127.0.0.1]/hook", wantErr: ErrBlockedAddress},
+		{name: "private allowed", url: "http://127.0.0.1:8080/hook", allow: true},
+		{name: "not http allowed", url: "ftp://127.0.0.1/hook", allow: true, wantErr: ErrInvalidURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSender(t, WithAllowPrivateAddresses(tt.allow))
+
+			err := s.ValidateURL(context.Background(), tt.url)
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("ValidateURL() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "93.184.215.14", expected: true},
+		{addr: "2606:4700::1111", expected: true},
+		{addr: "0.0.0.0"},
+		{addr: "127.0.0.1"},
+		{addr: "10.0.0.1"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "100.64.0.1"},
+		{addr: "169.254.169.254"},
+		{addr: "224.0.0.1"},
+		{addr: "255.255.255.255"},
+		{addr: "::"},
+		{addr: "::1"},
+		{addr: "::ffff:10.0.0.1"},
+		{addr: "fc00::1"},
+		{addr: "fd00:ec2::254"},
+		{addr: "fe80::1"},
+		{addr: "64:ff9b::a00:1"},
+		{addr: "2002:a00:1::"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := publicAddr(netip.MustParseAddr(tt.addr)); got != tt.expected {
+				t.Errorf("publicAddr(%s) = %v, want %v", tt.addr, got, tt.expected)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ window.onload = function () {
     window.ui = SwaggerUIBundle({
         urls: [
             {url: "/swagger/specs/notes.swagger.json", name: "swagger"},
+            {url: "/swagger/specs/notebooks.swagger.json", name: "notebooks"},
+            {url: "/swagger/specs/webhooks.swagger.json", name: "webhooks"},
         ],
         dom_id: '#swagger-ui',
         deepLinking: true,